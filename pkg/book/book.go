@@ -0,0 +1,172 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package book reconstructs per-symbol IEX order books from DEEP price level updates.
+// It does not care where the updates come from: the REST DEEP endpoints, a streaming
+// connection, or a HIST file replayed with package hist all feed the same Engine.
+//
+// IEX sends the price level changes caused by a single event, such as an order that
+// sweeps several levels, as a series of updates of which only the last is flagged as
+// complete. The Engine applies every update, but only publishes the book once the event
+// is complete, so readers never see a book that is in transition.
+package book
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// priceScale is the number of price units in a dollar. IEX prices have four decimal places,
+// so the Engine keys levels by integer price units to avoid floating point mismatches.
+const priceScale = 10000
+
+// Side is a side of the book.
+type Side int
+
+const (
+	Bid Side = iota + 1
+	Ask
+)
+
+// IsValid returns true if the Side is Bid or Ask.
+func (s Side) IsValid() bool {
+	return s == Bid || s == Ask
+}
+
+func (s Side) String() string {
+	switch s {
+	case Bid:
+		return "bid"
+	case Ask:
+		return "ask"
+	}
+	return fmt.Sprintf("Side(%d)", int(s))
+}
+
+// Level is the aggregate size displayed at a price.
+type Level struct {
+	Price float64
+	Size  int64
+}
+
+// Update is a change to the size displayed at one price level of a symbol's book.
+// A Size of zero removes the level.
+type Update struct {
+	Symbol    string
+	Side      Side
+	Price     float64
+	Size      int64
+	Timestamp time.Time
+	// Complete is true for the last update of an event. Updates with Complete false are
+	// applied, but the book is not published until an update completes the event.
+	Complete bool
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol is missing, the Side is invalid, the Price is not
+// positive, or the Size is negative.
+func (u *Update) Validate() error {
+	switch {
+	case u.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case !u.Side.IsValid():
+		return fmt.Errorf("invalid side: %s", u.Side)
+	case u.Price <= 0:
+		return fmt.Errorf("price is not positive")
+	case u.Size < 0:
+		return fmt.Errorf("size is negative")
+	}
+	return nil
+}
+
+// Trade is an execution on IEX. Trades do not change the book, since IEX sends price
+// level updates for the liquidity they remove, but the Engine keeps the last one.
+type Trade struct {
+	Symbol    string
+	Price     float64
+	Size      int64
+	TradeID   int64
+	Timestamp time.Time
+	// Break is true if the trade with TradeID was broken, i.e. cancelled.
+	Break bool
+}
+
+// Snapshot is a consistent view of a symbol's book. Bids are sorted from the highest
+// price, and asks from the lowest, so the best level of each side comes first.
+// The slices are shared between readers and must not be modified.
+type Snapshot struct {
+	Symbol string
+	Bids   []Level
+	Asks   []Level
+	// Timestamp is the time of the update that completed the last event.
+	Timestamp time.Time
+}
+
+// BestBid returns the highest bid, and false if there are no bids.
+func (s *Snapshot) BestBid() (Level, bool) {
+	if len(s.Bids) == 0 {
+		return Level{}, false
+	}
+	return s.Bids[0], true
+}
+
+// BestAsk returns the lowest ask, and false if there are no asks.
+func (s *Snapshot) BestAsk() (Level, bool) {
+	if len(s.Asks) == 0 {
+		return Level{}, false
+	}
+	return s.Asks[0], true
+}
+
+// Spread returns the difference between the best ask and best bid, and false if
+// either side is empty.
+func (s *Snapshot) Spread() (float64, bool) {
+	bid, ok := s.BestBid()
+	if !ok {
+		return 0, false
+	}
+	ask, ok := s.BestAsk()
+	if !ok {
+		return 0, false
+	}
+	return fromTicks(toTicks(ask.Price) - toTicks(bid.Price)), true
+}
+
+// Depth returns a Snapshot with at most n levels on each side. A negative n is treated as zero.
+func (s *Snapshot) Depth(n int) Snapshot {
+	if n < 0 {
+		n = 0
+	}
+	depth := *s
+	if n < len(depth.Bids) {
+		depth.Bids = depth.Bids[:n:n]
+	}
+	if n < len(depth.Asks) {
+		depth.Asks = depth.Asks[:n:n]
+	}
+	return depth
+}
+
+// toTicks converts a price into integer price units.
+func toTicks(price float64) int64 {
+	return int64(math.Round(price * priceScale))
+}
+
+// fromTicks undoes what toTicks does.
+func fromTicks(ticks int64) float64 {
+	return float64(ticks) / priceScale
+}
@@ -0,0 +1,104 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package book_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/book"
+)
+
+var _ = Describe("Book", func() {
+	Describe("Update", func() {
+		var update Update
+		BeforeEach(func() {
+			update = Update{Symbol: "AAPL", Side: Bid, Price: 120.5, Size: 100}
+		})
+
+		It("should be valid", func() {
+			Expect(update.Validate()).To(Succeed())
+		})
+		It("should allow a size of zero", func() {
+			update.Size = 0
+			Expect(update.Validate()).To(Succeed())
+		})
+		It("should require a symbol", func() {
+			update.Symbol = ""
+			Expect(update.Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should require a valid side", func() {
+			update.Side = 0
+			Expect(update.Validate()).To(MatchError("invalid side: Side(0)"))
+		})
+		It("should require a positive price", func() {
+			update.Price = 0
+			Expect(update.Validate()).To(MatchError("price is not positive"))
+		})
+		It("should not allow a negative size", func() {
+			update.Size = -1
+			Expect(update.Validate()).To(MatchError("size is negative"))
+		})
+	})
+
+	Describe("Snapshot", func() {
+		var snapshot Snapshot
+		BeforeEach(func() {
+			snapshot = Snapshot{
+				Symbol:    "AAPL",
+				Bids:      []Level{{Price: 120.1, Size: 100}, {Price: 120, Size: 200}},
+				Asks:      []Level{{Price: 120.3, Size: 300}},
+				Timestamp: time.Now(),
+			}
+		})
+
+		It("should return the best bid and ask", func() {
+			bid, ok := snapshot.BestBid()
+			Expect(ok).To(BeTrue())
+			Expect(bid).To(Equal(Level{Price: 120.1, Size: 100}))
+			ask, ok := snapshot.BestAsk()
+			Expect(ok).To(BeTrue())
+			Expect(ask).To(Equal(Level{Price: 120.3, Size: 300}))
+		})
+		It("should calculate the spread without floating point error", func() {
+			spread, ok := snapshot.Spread()
+			Expect(ok).To(BeTrue())
+			Expect(spread).To(Equal(0.2))
+		})
+		It("should not have a spread if a side is empty", func() {
+			snapshot.Asks = nil
+			_, ok := snapshot.Spread()
+			Expect(ok).To(BeFalse())
+			_, ok = snapshot.BestAsk()
+			Expect(ok).To(BeFalse())
+		})
+		It("should limit the depth of each side", func() {
+			depth := snapshot.Depth(1)
+			Expect(depth.Bids).To(Equal([]Level{{Price: 120.1, Size: 100}}))
+			Expect(depth.Asks).To(Equal(snapshot.Asks))
+			Expect(depth.Timestamp).To(Equal(snapshot.Timestamp))
+			Expect(snapshot.Bids).To(HaveLen(2))
+		})
+		It("should treat a negative depth as zero", func() {
+			depth := snapshot.Depth(-1)
+			Expect(depth.Bids).To(BeEmpty())
+			Expect(depth.Asks).To(BeEmpty())
+		})
+	})
+})
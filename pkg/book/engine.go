@@ -0,0 +1,320 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package book
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/onwsk8r/goiex/pkg/core/iex"
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/pkg/hist"
+)
+
+// Engine keeps the order book of every symbol it receives updates for.
+// It is safe for concurrent use: updates may be applied from one goroutine while
+// others read snapshots. Callbacks are called on the goroutine that applied the
+// update, after the Engine's lock is released, so they may call back into the Engine.
+type Engine struct {
+	mu       sync.RWMutex
+	books    map[string]*symbolBook
+	onChange []func(Snapshot)
+	onTrade  []func(Trade)
+}
+
+// symbolBook is the state of one symbol. The levels are the working book, which
+// may be in transition, while published is the book as of the last complete event.
+type symbolBook struct {
+	bids      []level // sorted by descending price
+	asks      []level // sorted by ascending price
+	pending   bool
+	published Snapshot
+	lastTrade *Trade
+}
+
+// level is a price level keyed by integer price units.
+type level struct {
+	ticks int64
+	size  int64
+}
+
+// NewEngine creates an Engine with no books.
+func NewEngine() *Engine {
+	return &Engine{books: make(map[string]*symbolBook)}
+}
+
+// OnChange registers a function that is called with the new Snapshot each time
+// an event completes and a book is published.
+func (e *Engine) OnChange(fn func(Snapshot)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.onChange = append(e.onChange, fn)
+}
+
+// OnTrade registers a function that is called with each trade and trade break.
+func (e *Engine) OnTrade(fn func(Trade)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.onTrade = append(e.onTrade, fn)
+}
+
+// Update applies a price level update, publishing the book if it completes an event.
+// It will return an error if the update is invalid, in which case the book is unchanged.
+func (e *Engine) Update(u Update) error {
+	if err := u.Validate(); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	b := e.book(u.Symbol)
+	if u.Side == Bid {
+		b.bids = setLevel(b.bids, toTicks(u.Price), u.Size, func(a, b int64) bool { return a > b })
+	} else {
+		b.asks = setLevel(b.asks, toTicks(u.Price), u.Size, func(a, b int64) bool { return a < b })
+	}
+	b.pending = !u.Complete
+	if b.pending {
+		e.mu.Unlock()
+		return nil
+	}
+	snapshot := b.publish(u.Symbol, u.Timestamp)
+	callbacks := e.onChange
+	e.mu.Unlock()
+
+	for _, fn := range callbacks {
+		fn(snapshot)
+	}
+	return nil
+}
+
+// Trade records a trade or trade break and passes it to the OnTrade callbacks.
+// A break of the symbol's last trade clears it, so LastTrade reports no trade.
+func (e *Engine) Trade(t Trade) {
+	e.mu.Lock()
+	if !t.Break {
+		e.book(t.Symbol).lastTrade = &t
+	} else if b, ok := e.books[t.Symbol]; ok && b.lastTrade != nil && b.lastTrade.TradeID == t.TradeID {
+		b.lastTrade = nil
+	}
+	callbacks := e.onTrade
+	e.mu.Unlock()
+
+	for _, fn := range callbacks {
+		fn(t)
+	}
+}
+
+// ApplySnapshot replaces the book of a symbol with the given levels, as returned by
+// the DEEP and DEEP Book endpoints, and publishes it. Any event in transition is discarded.
+func (e *Engine) ApplySnapshot(symbol string, bids, asks []stock.BookLevel, ts time.Time) {
+	e.mu.Lock()
+	b := e.book(symbol)
+	b.bids = fromBookLevels(bids, func(a, b int64) bool { return a > b })
+	b.asks = fromBookLevels(asks, func(a, b int64) bool { return a < b })
+	b.pending = false
+	snapshot := b.publish(symbol, ts)
+	callbacks := e.onChange
+	e.mu.Unlock()
+
+	for _, fn := range callbacks {
+		fn(snapshot)
+	}
+}
+
+// ApplyDEEP applies the book and the most recent trade of a response from the DEEP endpoint,
+// then passes each of its trade breaks to Trade.
+func (e *Engine) ApplyDEEP(d *iex.DEEP) {
+	e.ApplySnapshot(d.Symbol, d.Bids, d.Asks, d.LastUpdated)
+	var latest *stock.Trade
+	for idx := range d.Trades {
+		if latest == nil || d.Trades[idx].Timestamp.After(latest.Timestamp) {
+			latest = &d.Trades[idx]
+		}
+	}
+	if latest != nil {
+		e.Trade(fromStockTrade(d.Symbol, latest, false))
+	}
+	for idx := range d.TradeBreaks {
+		e.Trade(fromStockTrade(d.Symbol, &d.TradeBreaks[idx], true))
+	}
+}
+
+// ApplyHist applies a message read from a HIST file. Price level updates change the
+// book, trade reports and breaks are passed to Trade, and other messages are ignored.
+func (e *Engine) ApplyHist(m hist.Message) error {
+	switch msg := m.(type) {
+	case *hist.PriceLevelUpdate:
+		side := Ask
+		if msg.Side == hist.SideBuy {
+			side = Bid
+		}
+		return e.Update(Update{
+			Symbol:    msg.Symbol,
+			Side:      side,
+			Price:     msg.Price,
+			Size:      int64(msg.Size),
+			Timestamp: msg.Timestamp,
+			Complete:  msg.IsComplete(),
+		})
+	case *hist.TradeReport:
+		e.Trade(fromTradeReport(msg, false))
+	case *hist.TradeBreak:
+		e.Trade(fromTradeReport(&msg.TradeReport, true))
+	}
+	return nil
+}
+
+// Snapshot returns the book of a symbol as of its last complete event, and false
+// if the Engine has not seen the symbol.
+func (e *Engine) Snapshot(symbol string) (Snapshot, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	b, ok := e.books[symbol]
+	if !ok {
+		return Snapshot{}, false
+	}
+	return b.published, true
+}
+
+// BBO returns the best bid and offer of a symbol. The booleans are false for empty sides.
+func (e *Engine) BBO(symbol string) (bid Level, hasBid bool, ask Level, hasAsk bool) {
+	s, _ := e.Snapshot(symbol)
+	bid, hasBid = s.BestBid()
+	ask, hasAsk = s.BestAsk()
+	return
+}
+
+// Depth returns at most n levels of each side of a symbol's book.
+func (e *Engine) Depth(symbol string, n int) Snapshot {
+	s, _ := e.Snapshot(symbol)
+	return s.Depth(n)
+}
+
+// InTransition returns true if the symbol's last update did not complete its event.
+func (e *Engine) InTransition(symbol string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	b, ok := e.books[symbol]
+	return ok && b.pending
+}
+
+// LastTrade returns the last trade of a symbol, and false if there has not been one.
+func (e *Engine) LastTrade(symbol string) (Trade, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if b, ok := e.books[symbol]; ok && b.lastTrade != nil {
+		return *b.lastTrade, true
+	}
+	return Trade{}, false
+}
+
+// Symbols returns the symbols the Engine has books for, in alphabetical order.
+func (e *Engine) Symbols() []string {
+	e.mu.RLock()
+	symbols := make([]string, 0, len(e.books))
+	for symbol := range e.books {
+		symbols = append(symbols, symbol)
+	}
+	e.mu.RUnlock()
+	sort.Strings(symbols)
+	return symbols
+}
+
+// book returns the book of a symbol, creating it if necessary. The lock must be held.
+func (e *Engine) book(symbol string) *symbolBook {
+	b, ok := e.books[symbol]
+	if !ok {
+		b = &symbolBook{published: Snapshot{Symbol: symbol}}
+		e.books[symbol] = b
+	}
+	return b
+}
+
+// publish copies the working book into a new Snapshot.
+func (b *symbolBook) publish(symbol string, ts time.Time) Snapshot {
+	b.published = Snapshot{
+		Symbol:    symbol,
+		Bids:      toLevels(b.bids),
+		Asks:      toLevels(b.asks),
+		Timestamp: ts,
+	}
+	return b.published
+}
+
+// setLevel sets the size at a price in levels, which are sorted so that before(a, b)
+// is true when a comes first. A size of zero removes the level.
+func setLevel(levels []level, ticks, size int64, before func(a, b int64) bool) []level {
+	idx := sort.Search(len(levels), func(i int) bool { return !before(levels[i].ticks, ticks) })
+	found := idx < len(levels) && levels[idx].ticks == ticks
+	switch {
+	case found && size == 0:
+		return append(levels[:idx], levels[idx+1:]...)
+	case found:
+		levels[idx].size = size
+	case size > 0:
+		levels = append(levels, level{})
+		copy(levels[idx+1:], levels[idx:])
+		levels[idx] = level{ticks: ticks, size: size}
+	}
+	return levels
+}
+
+// toLevels converts the working levels into the published form.
+func toLevels(levels []level) []Level {
+	if len(levels) == 0 {
+		return nil
+	}
+	res := make([]Level, len(levels))
+	for idx, l := range levels {
+		res[idx] = Level{Price: fromTicks(l.ticks), Size: l.size}
+	}
+	return res
+}
+
+// fromBookLevels converts the levels returned by the REST endpoints, which may not be sorted.
+func fromBookLevels(levels []stock.BookLevel, before func(a, b int64) bool) []level {
+	var res []level
+	for _, l := range levels {
+		res = setLevel(res, toTicks(l.Price), int64(l.Size), before)
+	}
+	return res
+}
+
+// fromTradeReport converts a trade report read from a HIST file.
+func fromTradeReport(t *hist.TradeReport, broken bool) Trade {
+	return Trade{
+		Symbol:    t.Symbol,
+		Price:     t.Price,
+		Size:      int64(t.Size),
+		TradeID:   t.TradeID,
+		Timestamp: t.Timestamp,
+		Break:     broken,
+	}
+}
+
+// fromStockTrade converts a trade from a DEEP response, which does not include the symbol.
+func fromStockTrade(symbol string, t *stock.Trade, broken bool) Trade {
+	return Trade{
+		Symbol:    symbol,
+		Price:     t.Price,
+		Size:      int64(t.Size),
+		TradeID:   t.TradeID,
+		Timestamp: t.Timestamp,
+		Break:     broken,
+	}
+}
@@ -0,0 +1,253 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package book_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/book"
+	"github.com/onwsk8r/goiex/pkg/core/iex"
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/pkg/hist"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Engine", func() {
+	var engine *Engine
+	var published []Snapshot
+	var trades []Trade
+	t0 := time.Date(2021, time.July, 8, 13, 30, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		engine = NewEngine()
+		published = nil
+		trades = nil
+		engine.OnChange(func(s Snapshot) { published = append(published, s) })
+		engine.OnTrade(func(t Trade) { trades = append(trades, t) })
+	})
+
+	update := func(side Side, price float64, size int64, complete bool) {
+		ExpectWithOffset(1, engine.Update(Update{
+			Symbol:    "AAPL",
+			Side:      side,
+			Price:     price,
+			Size:      size,
+			Timestamp: t0,
+			Complete:  complete,
+		})).To(Succeed())
+	}
+
+	It("should keep each side sorted from the best price", func() {
+		update(Bid, 120, 100, true)
+		update(Bid, 120.2, 200, true)
+		update(Bid, 120.1, 300, true)
+		update(Ask, 120.5, 100, true)
+		update(Ask, 120.3, 200, true)
+		update(Ask, 120.4, 300, true)
+
+		s, ok := engine.Snapshot("AAPL")
+		Expect(ok).To(BeTrue())
+		Expect(s.Bids).To(Equal([]Level{{120.2, 200}, {120.1, 300}, {120, 100}}))
+		Expect(s.Asks).To(Equal([]Level{{120.3, 200}, {120.4, 300}, {120.5, 100}}))
+		Expect(s.Timestamp).To(Equal(t0))
+		Expect(published).To(HaveLen(6))
+	})
+
+	It("should change and remove levels", func() {
+		update(Bid, 120, 100, true)
+		update(Bid, 120.1, 100, true)
+		update(Bid, 120, 500, true)
+		update(Bid, 120.1, 0, true)
+		update(Bid, 119, 0, true)
+
+		s, _ := engine.Snapshot("AAPL")
+		Expect(s.Bids).To(Equal([]Level{{120, 500}}))
+		Expect(s.Asks).To(BeNil())
+	})
+
+	It("should only publish complete events", func() {
+		update(Bid, 120, 100, true)
+		update(Ask, 120.1, 100, true)
+		Expect(published).To(HaveLen(2))
+
+		// A buy order sweeps the ask and rests on the bid.
+		update(Ask, 120.1, 0, false)
+		Expect(engine.InTransition("AAPL")).To(BeTrue())
+		Expect(published).To(HaveLen(2))
+		s, _ := engine.Snapshot("AAPL")
+		Expect(s.Asks).To(Equal([]Level{{120.1, 100}}))
+
+		update(Bid, 120.1, 50, true)
+		Expect(engine.InTransition("AAPL")).To(BeFalse())
+		Expect(published).To(HaveLen(3))
+		Expect(published[2].Bids).To(Equal([]Level{{120.1, 50}, {120, 100}}))
+		Expect(published[2].Asks).To(BeNil())
+	})
+
+	It("should not change published snapshots", func() {
+		update(Bid, 120, 100, true)
+		before, _ := engine.Snapshot("AAPL")
+		update(Bid, 120, 200, true)
+		Expect(before.Bids).To(Equal([]Level{{120, 100}}))
+	})
+
+	It("should reject invalid updates", func() {
+		Expect(engine.Update(Update{Symbol: "AAPL", Side: Bid, Price: -1})).ToNot(Succeed())
+		Expect(engine.Symbols()).To(BeEmpty())
+		Expect(published).To(BeEmpty())
+	})
+
+	It("should return the best bid and offer and depth", func() {
+		update(Bid, 120, 100, true)
+		update(Bid, 119.9, 100, true)
+		update(Ask, 120.1, 300, true)
+
+		bid, hasBid, ask, hasAsk := engine.BBO("AAPL")
+		Expect(hasBid).To(BeTrue())
+		Expect(hasAsk).To(BeTrue())
+		Expect(bid).To(Equal(Level{120, 100}))
+		Expect(ask).To(Equal(Level{120.1, 300}))
+
+		depth := engine.Depth("AAPL", 1)
+		Expect(depth.Bids).To(Equal([]Level{{120, 100}}))
+		Expect(depth.Asks).To(Equal([]Level{{120.1, 300}}))
+	})
+
+	It("should handle unknown symbols", func() {
+		_, ok := engine.Snapshot("MSFT")
+		Expect(ok).To(BeFalse())
+		_, hasBid, _, hasAsk := engine.BBO("MSFT")
+		Expect(hasBid).To(BeFalse())
+		Expect(hasAsk).To(BeFalse())
+		Expect(engine.InTransition("MSFT")).To(BeFalse())
+		_, ok = engine.LastTrade("MSFT")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should keep a book per symbol", func() {
+		update(Bid, 120, 100, true)
+		Expect(engine.Update(Update{Symbol: "AMD", Side: Ask, Price: 80, Size: 10, Complete: true})).To(Succeed())
+		Expect(engine.Symbols()).To(Equal([]string{"AAPL", "AMD"}))
+		s, _ := engine.Snapshot("AMD")
+		Expect(s.Bids).To(BeNil())
+		Expect(s.Asks).To(Equal([]Level{{80, 10}}))
+	})
+
+	It("should keep the last trade when an older one is broken", func() {
+		engine.Trade(Trade{Symbol: "AAPL", Price: 120, Size: 100, TradeID: 2})
+		engine.Trade(Trade{Symbol: "AAPL", Price: 121, Size: 100, TradeID: 1, Break: true})
+		Expect(trades).To(HaveLen(2))
+		last, ok := engine.LastTrade("AAPL")
+		Expect(ok).To(BeTrue())
+		Expect(last.Price).To(Equal(120.0))
+	})
+
+	It("should allow callbacks to read the engine", func() {
+		var bids []Level
+		engine.OnChange(func(s Snapshot) {
+			snapshot, _ := engine.Snapshot(s.Symbol)
+			bids = snapshot.Bids
+		})
+		update(Bid, 120, 100, true)
+		Expect(bids).To(Equal([]Level{{120, 100}}))
+	})
+
+	Describe("ApplyDEEP", func() {
+		It("should replace the book and record the latest trade", func() {
+			update(Bid, 100, 100, true)
+			update(Ask, 100.5, 100, false)
+
+			engine.ApplyDEEP(&iex.DEEP{
+				Symbol:      "AAPL",
+				LastUpdated: t0,
+				Bids:        []stock.BookLevel{{Price: 120, Size: 100}, {Price: 120.1, Size: 200}},
+				Asks:        []stock.BookLevel{{Price: 120.2, Size: 300}},
+				Trades: []stock.Trade{
+					{Price: 120.15, Size: 100, TradeID: 2, Timestamp: t0.Add(time.Second)},
+					{Price: 120.05, Size: 100, TradeID: 1, Timestamp: t0},
+				},
+			})
+
+			Expect(engine.InTransition("AAPL")).To(BeFalse())
+			s, _ := engine.Snapshot("AAPL")
+			Expect(s.Bids).To(Equal([]Level{{120.1, 200}, {120, 100}}))
+			Expect(s.Asks).To(Equal([]Level{{120.2, 300}}))
+			Expect(s.Timestamp).To(Equal(t0))
+			last, ok := engine.LastTrade("AAPL")
+			Expect(ok).To(BeTrue())
+			Expect(last.TradeID).To(Equal(int64(2)))
+		})
+		It("should clear the last trade when it is broken", func() {
+			engine.ApplyDEEP(&iex.DEEP{
+				Symbol:      "AAPL",
+				LastUpdated: t0,
+				Trades:      []stock.Trade{{Price: 120.15, Size: 100, TradeID: 2, Timestamp: t0}},
+				TradeBreaks: []stock.Trade{{Price: 120.15, Size: 100, TradeID: 2, Timestamp: t0}},
+			})
+
+			Expect(trades).To(HaveLen(2))
+			Expect(trades[1].Break).To(BeTrue())
+			_, ok := engine.LastTrade("AAPL")
+			Expect(ok).To(BeFalse())
+		})
+		It("should pass the trade breaks to Trade", func() {
+			engine.ApplyDEEP(&iex.DEEP{
+				Symbol:      "AAPL",
+				LastUpdated: t0,
+				Trades:      []stock.Trade{{Price: 120.15, Size: 100, TradeID: 2, Timestamp: t0}},
+				TradeBreaks: []stock.Trade{{Price: 120.05, Size: 100, TradeID: 1, Timestamp: t0}},
+			})
+
+			Expect(trades).To(Equal([]Trade{
+				{Symbol: "AAPL", Price: 120.15, Size: 100, TradeID: 2, Timestamp: t0},
+				{Symbol: "AAPL", Price: 120.05, Size: 100, TradeID: 1, Timestamp: t0, Break: true},
+			}))
+			last, ok := engine.LastTrade("AAPL")
+			Expect(ok).To(BeTrue())
+			Expect(last.TradeID).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("ApplyHist", func() {
+		It("should replay a HIST file", func() {
+			rc, err := helper.Testdata("hist/deep.pcapng")
+			Expect(err).ToNot(HaveOccurred())
+			defer rc.Close()
+			s, err := hist.NewScanner(rc)
+			Expect(err).ToNot(HaveOccurred())
+			for s.Scan() {
+				Expect(engine.ApplyHist(s.Message())).To(Succeed())
+			}
+			Expect(s.Err()).ToNot(HaveOccurred())
+
+			// The sell update in the fixture does not complete its event.
+			Expect(engine.InTransition("ZIEXT")).To(BeTrue())
+			Expect(published).To(HaveLen(1))
+			snapshot, _ := engine.Snapshot("ZIEXT")
+			Expect(snapshot.Bids).To(Equal([]Level{{1.05, 100}}))
+			Expect(snapshot.Asks).To(BeNil())
+			Expect(snapshot.Timestamp).To(Equal(time.Unix(0, 1625751000100000000)))
+
+			Expect(trades).To(HaveLen(1))
+			Expect(trades[0].Break).To(BeTrue())
+			Expect(trades[0].TradeID).To(Equal(int64(42)))
+		})
+	})
+})
@@ -0,0 +1,102 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Book represents the data returned by the Crypto Book endpoint, which provides
+// the current bids and asks for a cryptocurrency.
+// https://iexcloud.io/docs/api/#cryptocurrency-book
+type Book struct {
+	Bids []BookLevel `json:"bids"`
+	Asks []BookLevel `json:"asks"`
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if any of the levels are invalid.
+func (b *Book) Validate() error {
+	for idx := range b.Bids {
+		if err := b.Bids[idx].Validate(); err != nil {
+			return fmt.Errorf("bid %d: %w", idx, err)
+		}
+	}
+	for idx := range b.Asks {
+		if err := b.Asks[idx].Validate(); err != nil {
+			return fmt.Errorf("ask %d: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+// BookLevel is the size offered at a price in a crypto Book.
+type BookLevel struct {
+	Price     Decimal   `json:"price" gorm:"type:numeric"`
+	Size      Decimal   `json:"size" gorm:"type:numeric"`
+	Timestamp time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the timestamp field, which is specified in
+// milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (b *BookLevel) UnmarshalJSON(data []byte) (err error) {
+	type bookLevel BookLevel
+	type embedded struct {
+		bookLevel
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*b = BookLevel(tmp.bookLevel)
+		if tmp.Timestamp > 0 {
+			b.Timestamp = time.Unix(tmp.Timestamp/1000, tmp.Timestamp%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (b *BookLevel) MarshalJSON() ([]byte, error) {
+	type bookLevel BookLevel
+	type embedded struct {
+		bookLevel
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.bookLevel = bookLevel(*b)
+	if !b.Timestamp.IsZero() {
+		tmp.Timestamp = b.Timestamp.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Price or Size is not positive.
+func (b *BookLevel) Validate() error {
+	switch {
+	case b.Price.Sign() <= 0:
+		return fmt.Errorf("price is not positive")
+	case b.Size.Sign() <= 0:
+		return fmt.Errorf("size is not positive")
+	}
+	return nil
+}
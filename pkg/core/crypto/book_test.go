@@ -0,0 +1,77 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/crypto"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Book", func() {
+	var expected *Book
+	BeforeEach(func() {
+		expected = &Book{
+			Bids: []BookLevel{{
+				Price:     MustDecimal("33984.9"),
+				Size:      MustDecimal("0.0504"),
+				Timestamp: time.Date(2021, time.July, 8, 16, 0, 0, 456e6, time.UTC),
+			}, {
+				Price:     MustDecimal("33984.12"),
+				Size:      MustDecimal("2.5"),
+				Timestamp: time.Date(2021, time.July, 8, 16, 0, 0, 391e6, time.UTC),
+			}},
+			Asks: []BookLevel{{
+				Price:     MustDecimal("33986.11"),
+				Size:      MustDecimal("1.23456789"),
+				Timestamp: time.Date(2021, time.July, 8, 16, 0, 0, 402e6, time.UTC),
+			}},
+		}
+	})
+
+	It("should parse a Book correctly", func() {
+		var res *Book
+		helper.TestdataFromJSON("core/crypto/book.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenBook()) {
+			helper.ToGolden("book", expected)
+			Fail(cmp.Diff(expected, GoldenBook()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Book is valid", func() {
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should return an error if a bid price is not positive", func() {
+			expected.Bids[1].Price = MustDecimal("0")
+			Expect(expected.Validate()).To(MatchError("bid 1: price is not positive"))
+		})
+		It("should return an error if an ask size is not positive", func() {
+			expected.Asks[0].Size = Decimal{}
+			Expect(expected.Validate()).To(MatchError("ask 0: size is not positive"))
+		})
+	})
+})
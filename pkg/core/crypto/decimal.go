@@ -0,0 +1,234 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Decimal is an exact decimal number. IEX returns crypto prices and sizes as strings
+// with more precision than a float64 can represent, so they are stored as a big.Rat.
+// The zero value is an unset Decimal, which corresponds to a JSON null, and behaves as zero.
+// Decimals are immutable: the arithmetic methods return a new Decimal.
+type Decimal struct {
+	rat *big.Rat
+}
+
+// NewDecimal parses a decimal string, such as "6068.12" or "1.5e-8".
+// It will return an error if the string is not a decimal number.
+func NewDecimal(s string) (Decimal, error) {
+	if strings.ContainsRune(s, '/') {
+		return Decimal{}, fmt.Errorf("invalid decimal: %q", s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal: %q", s)
+	}
+	return Decimal{rat: r}, nil
+}
+
+// MustDecimal is like NewDecimal, but panics if the string cannot be parsed.
+func MustDecimal(s string) Decimal {
+	d, err := NewDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Valid returns true if the Decimal is set.
+func (d Decimal) Valid() bool {
+	return d.rat != nil
+}
+
+// Rat returns the value of the Decimal as a new big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	if d.rat == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(d.rat)
+}
+
+// Float64 returns the nearest float64 to the Decimal.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// Sign returns -1, 0, or 1 depending on whether the Decimal is negative, zero, or positive.
+func (d Decimal) Sign() int {
+	if d.rat == nil {
+		return 0
+	}
+	return d.rat.Sign()
+}
+
+// IsZero returns true if the Decimal is zero or unset.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compares the Decimal to another, returning -1, 0, or 1 like big.Rat.Cmp.
+func (d Decimal) Cmp(other Decimal) int {
+	return d.Rat().Cmp(other.Rat())
+}
+
+// Equal returns true if both Decimals are unset, or both are set to the same value.
+func (d Decimal) Equal(other Decimal) bool {
+	if d.rat == nil || other.rat == nil {
+		return d.rat == nil && other.rat == nil
+	}
+	return d.rat.Cmp(other.rat) == 0
+}
+
+// Add returns the sum of the Decimal and another.
+func (d Decimal) Add(other Decimal) Decimal {
+	return Decimal{rat: new(big.Rat).Add(d.Rat(), other.Rat())}
+}
+
+// Sub returns the difference of the Decimal and another.
+func (d Decimal) Sub(other Decimal) Decimal {
+	return Decimal{rat: new(big.Rat).Sub(d.Rat(), other.Rat())}
+}
+
+// Mul returns the product of the Decimal and another.
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{rat: new(big.Rat).Mul(d.Rat(), other.Rat())}
+}
+
+// String returns the Decimal with as many decimal places as it needs, e.g. "0.0113".
+// Since a Decimal can only be created from decimal numbers, its value is always exact.
+func (d Decimal) String() string {
+	if d.rat == nil {
+		return "0"
+	}
+	return d.rat.FloatString(d.scale())
+}
+
+// scale returns the number of decimal places needed to represent the Decimal,
+// which is the larger of the powers of two and five in its reduced denominator.
+func (d Decimal) scale() int {
+	denom := new(big.Int).Set(d.rat.Denom())
+	mod := new(big.Int)
+	count := func(factor int64) (n int) {
+		f := big.NewInt(factor)
+		for {
+			q, m := new(big.Int).QuoRem(denom, f, mod)
+			if m.Sign() != 0 {
+				return
+			}
+			denom = q
+			n++
+		}
+	}
+	twos, fives := count(2), count(5) // nolint:gomnd
+	if twos > fives {
+		return twos
+	}
+	return fives
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// It accepts a quoted decimal string, which is how IEX sends crypto values, as well as
+// a number. Null and the empty string leave the Decimal unset.
+// It will return an error if the value is not a decimal number.
+func (d *Decimal) UnmarshalJSON(data []byte) (err error) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*d = Decimal{}
+		return nil
+	}
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err = json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*d = Decimal{}
+			return nil
+		}
+	}
+	*d, err = NewDecimal(s)
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does, writing the Decimal as a string, or null if it is unset.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if d.rat == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// GobEncode satisfies the gob.GobEncoder interface.
+func (d Decimal) GobEncode() ([]byte, error) {
+	if d.rat == nil {
+		return nil, nil
+	}
+	return []byte(d.String()), nil
+}
+
+// GobDecode satisfies the gob.GobDecoder interface.
+// It undoes what GobEncode does.
+func (d *Decimal) GobDecode(data []byte) (err error) {
+	if len(data) == 0 {
+		*d = Decimal{}
+		return nil
+	}
+	*d, err = NewDecimal(string(data))
+	return
+}
+
+// Scan satisfies the sql.Scanner interface.
+// Numeric columns are scanned as strings or bytes by most drivers, which preserves precision.
+func (d *Decimal) Scan(value interface{}) (err error) {
+	switch v := value.(type) {
+	case nil:
+		*d = Decimal{}
+	case string:
+		*d, err = NewDecimal(v)
+	case []byte:
+		*d, err = NewDecimal(string(v))
+	case int64:
+		*d = Decimal{rat: new(big.Rat).SetInt64(v)}
+	case float64:
+		r := new(big.Rat)
+		if r.SetFloat64(v) == nil {
+			return fmt.Errorf("invalid decimal: %v", v)
+		}
+		*d = Decimal{rat: r}
+	default:
+		return fmt.Errorf("cannot scan %T into a Decimal", value)
+	}
+	return
+}
+
+// Value satisfies the driver.Valuer interface.
+// It returns the Decimal as a string, which databases convert to a numeric column
+// without loss of precision, or nil if it is unset.
+func (d Decimal) Value() (driver.Value, error) {
+	if d.rat == nil {
+		return nil, nil
+	}
+	return d.String(), nil
+}
@@ -0,0 +1,128 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/crypto"
+)
+
+var _ = Describe("Decimal", func() {
+	It("should parse decimal strings exactly", func() {
+		d, err := NewDecimal("33985.12345678")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(d.Valid()).To(BeTrue())
+		Expect(d.Rat()).To(Equal(big.NewRat(3398512345678, 1e8)))
+		Expect(d.String()).To(Equal("33985.12345678"))
+	})
+	It("should parse exponents", func() {
+		Expect(MustDecimal("1.5e-8").String()).To(Equal("0.000000015"))
+		Expect(MustDecimal("2E3").String()).To(Equal("2000"))
+	})
+	It("should reject fractions and garbage", func() {
+		_, err := NewDecimal("1/3")
+		Expect(err).To(MatchError(`invalid decimal: "1/3"`))
+		_, err = NewDecimal("abc")
+		Expect(err).To(HaveOccurred())
+		Expect(func() { MustDecimal("") }).To(Panic())
+	})
+	It("should behave as zero when unset", func() {
+		var d Decimal
+		Expect(d.Valid()).To(BeFalse())
+		Expect(d.IsZero()).To(BeTrue())
+		Expect(d.String()).To(Equal("0"))
+		Expect(d.Float64()).To(Equal(0.0))
+		Expect(d.Add(MustDecimal("1.5")).String()).To(Equal("1.5"))
+	})
+	It("should do exact arithmetic", func() {
+		a, b := MustDecimal("0.1"), MustDecimal("0.2")
+		Expect(a.Add(b).Equal(MustDecimal("0.3"))).To(BeTrue())
+		Expect(b.Sub(a).String()).To(Equal("0.1"))
+		Expect(MustDecimal("33984.9").Mul(MustDecimal("0.0504")).String()).To(Equal("1712.83896"))
+		Expect(a.Cmp(b)).To(Equal(-1))
+		Expect(a.Sub(b).Sign()).To(Equal(-1))
+		Expect(a.String()).To(Equal("0.1"))
+	})
+	It("should compare unset and zero Decimals as different", func() {
+		Expect(Decimal{}.Equal(Decimal{})).To(BeTrue())
+		Expect(Decimal{}.Equal(MustDecimal("0"))).To(BeFalse())
+		Expect(MustDecimal("1.50").Equal(MustDecimal("1.5"))).To(BeTrue())
+	})
+
+	Describe("JSON", func() {
+		It("should unmarshal strings, numbers and nulls", func() {
+			var res struct{ A, B, C, D Decimal }
+			Expect(json.Unmarshal([]byte(`{"A":"0.0113","B":12.5,"C":null,"D":""}`), &res)).To(Succeed())
+			Expect(res.A.String()).To(Equal("0.0113"))
+			Expect(res.B.String()).To(Equal("12.5"))
+			Expect(res.C.Valid()).To(BeFalse())
+			Expect(res.D.Valid()).To(BeFalse())
+		})
+		It("should return an error for invalid values", func() {
+			var d Decimal
+			Expect(json.Unmarshal([]byte(`"twelve"`), &d)).To(HaveOccurred())
+			Expect(json.Unmarshal([]byte(`true`), &d)).To(HaveOccurred())
+		})
+		It("should marshal strings and nulls", func() {
+			data, err := json.Marshal([]Decimal{MustDecimal("0.0113"), {}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`["0.0113",null]`))
+		})
+	})
+
+	Describe("SQL", func() {
+		It("should be a driver.Valuer", func() {
+			Expect(MustDecimal("1.25").Value()).To(Equal(driver.Value("1.25")))
+			Expect(Decimal{}.Value()).To(BeNil())
+		})
+		It("should scan the types drivers return", func() {
+			var d Decimal
+			Expect(d.Scan("1.25")).To(Succeed())
+			Expect(d.String()).To(Equal("1.25"))
+			Expect(d.Scan([]byte("0.5"))).To(Succeed())
+			Expect(d.String()).To(Equal("0.5"))
+			Expect(d.Scan(int64(3))).To(Succeed())
+			Expect(d.String()).To(Equal("3"))
+			Expect(d.Scan(0.25)).To(Succeed())
+			Expect(d.String()).To(Equal("0.25"))
+			Expect(d.Scan(nil)).To(Succeed())
+			Expect(d.Valid()).To(BeFalse())
+			Expect(d.Scan(true)).To(MatchError("cannot scan bool into a Decimal"))
+		})
+	})
+
+	Describe("Gob", func() {
+		It("should round trip", func() {
+			data, err := MustDecimal("33985.12345678").GobEncode()
+			Expect(err).ToNot(HaveOccurred())
+			var d Decimal
+			Expect(d.GobDecode(data)).To(Succeed())
+			Expect(d.String()).To(Equal("33985.12345678"))
+
+			data, err = Decimal{}.GobEncode()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(d.GobDecode(data)).To(Succeed())
+			Expect(d.Valid()).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,45 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+// GoldenQuote returns golden data for the Quote type
+func GoldenQuote() (q *Quote) {
+	helper.FromGolden("quote", &q)
+	return
+}
+
+// GoldenPrice returns golden data for the Price type
+func GoldenPrice() (p *Price) {
+	helper.FromGolden("price", &p)
+	return
+}
+
+// GoldenBook returns golden data for the Book type
+func GoldenBook() (b *Book) {
+	helper.FromGolden("book", &b)
+	return
+}
+
+// GoldenSymbol returns golden data for the Symbol type
+func GoldenSymbol() (s []Symbol) {
+	helper.FromGolden("symbol", &s)
+	return
+}
@@ -0,0 +1,41 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"fmt"
+)
+
+// Price represents the data returned by the Crypto Price endpoint, which provides
+// the latest price of a cryptocurrency.
+// https://iexcloud.io/docs/api/#cryptocurrency-price
+type Price struct {
+	Symbol string  `json:"symbol" gorm:"primaryKey;type:character varying"`
+	Price  Decimal `json:"price" gorm:"type:numeric"`
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol is missing or the Price is not positive.
+func (p *Price) Validate() error {
+	switch {
+	case p.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case p.Price.Sign() <= 0:
+		return fmt.Errorf("price is not positive")
+	}
+	return nil
+}
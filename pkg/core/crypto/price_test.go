@@ -0,0 +1,60 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto_test
+
+import (
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/crypto"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Price", func() {
+	var expected *Price
+	BeforeEach(func() {
+		expected = &Price{Symbol: "BTCUSD", Price: MustDecimal("33985.12345678")}
+	})
+
+	It("should parse a Price correctly", func() {
+		var res *Price
+		helper.TestdataFromJSON("core/crypto/price.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenPrice()) {
+			helper.ToGolden("price", expected)
+			Fail(cmp.Diff(expected, GoldenPrice()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Price is valid", func() {
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should return an error if the Symbol is missing", func() {
+			expected.Symbol = ""
+			Expect(expected.Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the Price is not positive", func() {
+			expected.Price = Decimal{}
+			Expect(expected.Validate()).To(MatchError("price is not positive"))
+		})
+	})
+})
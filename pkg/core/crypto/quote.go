@@ -0,0 +1,107 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package crypto contains the models for the cryptocurrency endpoints. Prices and sizes
+// are Decimals rather than float64s, since IEX sends them as high precision strings.
+// https://iexcloud.io/docs/api/#cryptocurrency
+package crypto
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Quote represents the data returned by the Crypto Quote endpoint, which provides
+// the latest quote for a cryptocurrency.
+// https://iexcloud.io/docs/api/#cryptocurrency-quote
+type Quote struct {
+	Symbol           string    `json:"symbol" gorm:"primaryKey;type:character varying"`
+	PrimaryExchange  string    `json:"primaryExchange" gorm:"type:character varying"`
+	Sector           string    `json:"sector" gorm:"type:character varying"`
+	CalculationPrice string    `json:"calculationPrice" gorm:"type:character varying"`
+	LatestPrice      Decimal   `json:"latestPrice" gorm:"type:numeric"`
+	LatestSource     string    `json:"latestSource" gorm:"type:character varying"`
+	LatestUpdate     time.Time `json:"-" gorm:"primaryKey"`
+	LatestVolume     Decimal   `json:"latestVolume" gorm:"type:numeric"`
+	BidPrice         Decimal   `json:"bidPrice" gorm:"type:numeric"`
+	BidSize          Decimal   `json:"bidSize" gorm:"type:numeric"`
+	AskPrice         Decimal   `json:"askPrice" gorm:"type:numeric"`
+	AskSize          Decimal   `json:"askSize" gorm:"type:numeric"`
+	High             Decimal   `json:"high" gorm:"type:numeric"`
+	Low              Decimal   `json:"low" gorm:"type:numeric"`
+	PreviousClose    Decimal   `json:"previousClose" gorm:"type:numeric"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the latestUpdate field, which is specified in
+// milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (q *Quote) UnmarshalJSON(data []byte) (err error) {
+	type quote Quote
+	type embedded struct {
+		quote
+		LatestUpdate int64 `json:"latestUpdate,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*q = Quote(tmp.quote)
+		if tmp.LatestUpdate > 0 {
+			q.LatestUpdate = time.Unix(tmp.LatestUpdate/1000, tmp.LatestUpdate%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (q *Quote) MarshalJSON() ([]byte, error) {
+	type quote Quote
+	type embedded struct {
+		quote
+		LatestUpdate int64 `json:"latestUpdate,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.quote = quote(*q)
+	if !q.LatestUpdate.IsZero() {
+		tmp.LatestUpdate = q.LatestUpdate.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Spread returns the difference between the ask and bid prices.
+func (q *Quote) Spread() Decimal {
+	return q.AskPrice.Sub(q.BidPrice)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol, LatestPrice, or LatestUpdate fields are
+// equal to their zero value, or if a price or size is negative.
+func (q *Quote) Validate() error {
+	switch {
+	case q.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case q.LatestPrice.IsZero():
+		return fmt.Errorf("latest price is zero")
+	case q.LatestUpdate.IsZero():
+		return fmt.Errorf("latest update is missing")
+	case q.BidPrice.Sign() < 0 || q.AskPrice.Sign() < 0:
+		return fmt.Errorf("bid or ask price is negative")
+	case q.BidSize.Sign() < 0 || q.AskSize.Sign() < 0:
+		return fmt.Errorf("bid or ask size is negative")
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/crypto"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Quote", func() {
+	var expected *Quote
+	BeforeEach(func() {
+		expected = &Quote{
+			Symbol:           "BTCUSD",
+			PrimaryExchange:  "Paxos",
+			Sector:           "cryptocurrency",
+			CalculationPrice: "realtime",
+			LatestPrice:      MustDecimal("33985.12345678"),
+			LatestSource:     "Real time price",
+			LatestUpdate:     time.Date(2021, time.July, 8, 16, 0, 0, 123e6, time.UTC),
+			LatestVolume:     MustDecimal("0.00350021"),
+			BidPrice:         MustDecimal("33984.9"),
+			BidSize:          MustDecimal("0.0504"),
+			AskPrice:         MustDecimal("33986.11"),
+			AskSize:          MustDecimal("1.23456789"),
+		}
+	})
+
+	It("should parse a Quote correctly", func() {
+		var res *Quote
+		helper.TestdataFromJSON("core/crypto/quote.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenQuote()) {
+			helper.ToGolden("quote", expected)
+			Fail(cmp.Diff(expected, GoldenQuote()))
+		}
+	})
+
+	It("should calculate the spread", func() {
+		Expect(expected.Spread().String()).To(Equal("1.21"))
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Quote is valid", func() {
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should return an error if the Symbol is missing", func() {
+			expected.Symbol = ""
+			Expect(expected.Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the LatestPrice is zero", func() {
+			expected.LatestPrice = Decimal{}
+			Expect(expected.Validate()).To(MatchError("latest price is zero"))
+		})
+		It("should return an error if the LatestUpdate is missing", func() {
+			expected.LatestUpdate = time.Time{}
+			Expect(expected.Validate()).To(MatchError("latest update is missing"))
+		})
+		It("should return an error if a price is negative", func() {
+			expected.AskPrice = MustDecimal("-1")
+			Expect(expected.Validate()).To(MatchError("bid or ask price is negative"))
+		})
+		It("should return an error if a size is negative", func() {
+			expected.BidSize = MustDecimal("-0.1")
+			Expect(expected.Validate()).To(MatchError("bid or ask size is negative"))
+		})
+	})
+})
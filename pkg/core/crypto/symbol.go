@@ -0,0 +1,82 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Symbol represents one datum of that returned by the ref-data/crypto/symbols endpoint.
+// https://iexcloud.io/docs/api/#cryptocurrency-symbols
+type Symbol struct {
+	Symbol   string    `json:"symbol" gorm:"primaryKey;type:character varying"`
+	Name     string    `json:"name" gorm:"type:character varying"`
+	Exchange string    `json:"exchange" gorm:"type:character varying"`
+	Date     time.Time `json:"-" gorm:"type:date"`
+	Type     string    `json:"type" gorm:"type:character varying"`
+	Region   string    `json:"region" gorm:"type:character(2)"`
+	Currency string    `json:"currency" gorm:"type:character(3)"`
+	Enabled  bool      `json:"isEnabled"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date field, which is specified as "YYYY-MM-DD",
+// into a time.Time by using time.Parse().
+// It will return an error if the JSON cannot be unmarshaled.
+func (s *Symbol) UnmarshalJSON(data []byte) (err error) {
+	type symbol Symbol
+	type embedded struct {
+		symbol
+		Date string `json:"date"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*s = Symbol(tmp.symbol)
+		s.Date, _ = time.Parse("2006-01-02", tmp.Date) // nolint:errcheck
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (s *Symbol) MarshalJSON() ([]byte, error) {
+	type symbol Symbol
+	type embedded struct {
+		symbol
+		Date string `json:"date,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.symbol = symbol(*s)
+	if !s.Date.IsZero() {
+		tmp.Date = s.Date.Format("2006-01-02")
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol or Currency is missing.
+func (s *Symbol) Validate() error {
+	switch {
+	case s.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case s.Currency == "":
+		return fmt.Errorf("currency is missing")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/crypto"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Symbol", func() {
+	var expected []Symbol
+	BeforeEach(func() {
+		date := time.Date(2021, time.July, 8, 0, 0, 0, 0, time.UTC)
+		expected = []Symbol{{
+			Symbol:   "BTCUSD",
+			Name:     "Bitcoin to USD",
+			Exchange: "PAXOS",
+			Date:     date,
+			Type:     "crypto",
+			Region:   "US",
+			Currency: "USD",
+			Enabled:  true,
+		}, {
+			Symbol:   "ETHUSD",
+			Name:     "Ethereum to USD",
+			Exchange: "PAXOS",
+			Date:     date,
+			Type:     "crypto",
+			Region:   "US",
+			Currency: "USD",
+			Enabled:  true,
+		}}
+	})
+
+	It("should parse Symbols correctly", func() {
+		var res []Symbol
+		helper.TestdataFromJSON("core/crypto/symbols.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenSymbol()) {
+			helper.ToGolden("symbol", expected)
+			Fail(cmp.Diff(expected, GoldenSymbol()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Symbol is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the Symbol is missing", func() {
+			expected[0].Symbol = ""
+			Expect(expected[0].Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the Currency is missing", func() {
+			expected[0].Currency = ""
+			Expect(expected[0].Validate()).To(MatchError("currency is missing"))
+		})
+	})
+})
@@ -0,0 +1,81 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package datapoint contains the models for the data points endpoints, which return
+// single fields of IEX datasets by key at a much lower cost than the full datasets.
+// https://iexcloud.io/docs/api/#data-points
+package datapoint
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Metadata represents one datum of that returned by the data points list endpoint,
+// which describes a data point available for a symbol.
+type Metadata struct {
+	Key         string    `json:"key" gorm:"primaryKey;type:character varying"`
+	Weight      int64     `json:"weight"`
+	Description string    `json:"description" gorm:"type:character varying"`
+	LastUpdated time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the lastUpdated field, which is specified as an
+// RFC 3339 timestamp, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled, but NOT if the time parsing fails.
+func (m *Metadata) UnmarshalJSON(data []byte) (err error) {
+	type metadata Metadata
+	type embedded struct {
+		metadata
+		LastUpdated string `json:"lastUpdated"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*m = Metadata(tmp.metadata)
+		m.LastUpdated, _ = time.Parse(time.RFC3339, tmp.LastUpdated) // nolint:errcheck
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (m *Metadata) MarshalJSON() ([]byte, error) {
+	type metadata Metadata
+	type embedded struct {
+		metadata
+		LastUpdated string `json:"lastUpdated,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.metadata = metadata(*m)
+	if !m.LastUpdated.IsZero() {
+		tmp.LastUpdated = m.LastUpdated.Format(time.RFC3339)
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Key is missing or the Weight is negative.
+func (m *Metadata) Validate() error {
+	switch {
+	case m.Key == "":
+		return fmt.Errorf("key is missing")
+	case m.Weight < 0:
+		return fmt.Errorf("weight is negative")
+	}
+	return nil
+}
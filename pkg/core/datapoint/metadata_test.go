@@ -0,0 +1,77 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package datapoint_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/datapoint"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Metadata", func() {
+	var expected []Metadata
+	BeforeEach(func() {
+		expected = []Metadata{{
+			Key:         "QUOTE-LATESTPRICE",
+			Weight:      1,
+			Description: "Quote: latestPrice",
+			LastUpdated: time.Date(2021, time.July, 8, 20, 0, 0, 0, time.UTC),
+		}, {
+			Key:         "COMPANYNAME",
+			Weight:      1,
+			Description: "Company: companyName",
+			LastUpdated: time.Date(2021, time.July, 8, 12, 31, 5, 0, time.UTC),
+		}, {
+			Key:         "NEXTDIVIDENDDATE",
+			Weight:      1,
+			Description: "Key Stats: nextDividendDate",
+			LastUpdated: time.Date(2021, time.July, 8, 8, 15, 40, 0, time.UTC),
+		}}
+	})
+
+	It("should parse Metadata correctly", func() {
+		var res []Metadata
+		helper.TestdataFromJSON("core/datapoint/metadata.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenMetadata()) {
+			helper.ToGolden("metadata", expected)
+			Fail(cmp.Diff(expected, GoldenMetadata()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Metadata is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the Key is missing", func() {
+			expected[0].Key = ""
+			Expect(expected[0].Validate()).To(MatchError("key is missing"))
+		})
+		It("should return an error if the Weight is negative", func() {
+			expected[0].Weight = -1
+			Expect(expected[0].Validate()).To(MatchError("weight is negative"))
+		})
+	})
+})
@@ -0,0 +1,190 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package datapoint
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Kind is the JSON type of a Value.
+type Kind int
+
+const (
+	KindNull Kind = iota
+	KindNumber
+	KindString
+	KindBool
+	KindOther
+)
+
+// Value is the value of a data point. IEX returns numbers, strings, booleans, and dates
+// from the same endpoint, so a Value keeps the raw JSON and is read with the method that
+// matches the data point, such as Float64 for a price. Metadata does not say which that
+// is, but Kind reports what was returned. The zero value is null.
+type Value struct {
+	raw json.RawMessage
+}
+
+// NewStringValue returns a string Value, for data points returned as plain text.
+func NewStringValue(s string) Value {
+	raw, _ := json.Marshal(s) // nolint:errcheck
+	return Value{raw: raw}
+}
+
+// Kind returns the JSON type of the Value. Dates are strings.
+func (v Value) Kind() Kind {
+	if len(v.raw) == 0 {
+		return KindNull
+	}
+	switch c := v.raw[0]; {
+	case c == 'n':
+		return KindNull
+	case c == '"':
+		return KindString
+	case c == 't' || c == 'f':
+		return KindBool
+	case c == '-' || (c >= '0' && c <= '9'):
+		return KindNumber
+	}
+	return KindOther
+}
+
+// IsNull returns true if the data point has no value.
+func (v Value) IsNull() bool {
+	return v.Kind() == KindNull
+}
+
+// Raw returns the JSON of the Value.
+func (v Value) Raw() []byte {
+	if len(v.raw) == 0 {
+		return []byte("null")
+	}
+	return v.raw
+}
+
+// Float64 returns the Value as a number. Numeric strings are converted.
+// It will return an error if the Value is not a number.
+func (v Value) Float64() (float64, error) {
+	switch v.Kind() {
+	case KindNumber:
+		return strconv.ParseFloat(string(v.raw), 64)
+	case KindString:
+		s, _ := v.str()
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f, nil
+		}
+	}
+	return 0, fmt.Errorf("data point %s is not a number", v.Raw())
+}
+
+// String returns the Value as a string. Strings are unquoted, null is the empty
+// string, and other values are returned as JSON.
+func (v Value) String() string {
+	switch v.Kind() {
+	case KindNull:
+		return ""
+	case KindString:
+		if s, err := v.str(); err == nil {
+			return s
+		}
+	}
+	return string(v.raw)
+}
+
+// Bool returns the Value as a boolean. The strings "true" and "false" are converted.
+// It will return an error if the Value is not a boolean.
+func (v Value) Bool() (bool, error) {
+	switch v.Kind() {
+	case KindBool:
+		return v.raw[0] == 't', nil
+	case KindString:
+		s, _ := v.str()
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+	return false, fmt.Errorf("data point %s is not a boolean", v.Raw())
+}
+
+// Time returns the Value as a time. Strings formatted as "YYYY-MM-DD" or RFC 3339
+// are parsed, and numbers are taken as milliseconds since the epoch.
+// It will return an error if the Value is not a date.
+func (v Value) Time() (time.Time, error) {
+	switch v.Kind() {
+	case KindNumber:
+		if ms, err := strconv.ParseInt(string(v.raw), 10, 64); err == nil {
+			return time.Unix(ms/1000, ms%1000*1e6), nil // nolint:gomnd
+		}
+	case KindString:
+		s, _ := v.str()
+		for _, layout := range []string{"2006-01-02", time.RFC3339} {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("data point %s is not a date", v.Raw())
+}
+
+// Equal returns true if the Values have the same JSON, ignoring insignificant space.
+func (v Value) Equal(other Value) bool {
+	var a, b bytes.Buffer
+	if json.Compact(&a, v.Raw()) != nil || json.Compact(&b, other.Raw()) != nil {
+		return bytes.Equal(v.Raw(), other.Raw())
+	}
+	return bytes.Equal(a.Bytes(), b.Bytes())
+}
+
+// str unquotes a string Value.
+func (v Value) str() (s string, err error) {
+	err = json.Unmarshal(v.raw, &s)
+	return
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// It keeps a copy of the JSON.
+// It will return an error if the data is not valid JSON.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if !json.Valid(data) {
+		return fmt.Errorf("invalid data point: %q", data)
+	}
+	v.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (v Value) MarshalJSON() ([]byte, error) {
+	return v.Raw(), nil
+}
+
+// GobEncode satisfies the gob.GobEncoder interface.
+func (v Value) GobEncode() ([]byte, error) {
+	return v.raw, nil
+}
+
+// GobDecode satisfies the gob.GobDecoder interface.
+// It undoes what GobEncode does.
+func (v *Value) GobDecode(data []byte) error {
+	v.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
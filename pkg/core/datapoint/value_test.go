@@ -0,0 +1,101 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package datapoint_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/datapoint"
+)
+
+var _ = Describe("Value", func() {
+	parse := func(data string) (v Value) {
+		ExpectWithOffset(1, json.Unmarshal([]byte(data), &v)).To(Succeed())
+		return
+	}
+
+	It("should read numbers", func() {
+		v := parse("144.57")
+		Expect(v.Kind()).To(Equal(KindNumber))
+		Expect(v.Float64()).To(Equal(144.57))
+		Expect(parse(`"-0.5"`).Float64()).To(Equal(-0.5))
+		_, err := parse(`"Apple Inc."`).Float64()
+		Expect(err).To(MatchError(`data point "Apple Inc." is not a number`))
+	})
+	It("should read strings", func() {
+		v := parse(`"Apple Inc."`)
+		Expect(v.Kind()).To(Equal(KindString))
+		Expect(v.String()).To(Equal("Apple Inc."))
+		Expect(parse("144.57").String()).To(Equal("144.57"))
+		Expect(parse("null").String()).To(BeEmpty())
+	})
+	It("should make string values", func() {
+		v := NewStringValue(`Apple "Inc."`)
+		Expect(v.Kind()).To(Equal(KindString))
+		Expect(v.String()).To(Equal(`Apple "Inc."`))
+		Expect(v.Equal(parse(`"Apple \"Inc.\""`))).To(BeTrue())
+	})
+	It("should read booleans", func() {
+		v := parse("true")
+		Expect(v.Kind()).To(Equal(KindBool))
+		Expect(v.Bool()).To(BeTrue())
+		Expect(parse(`"false"`).Bool()).To(BeFalse())
+		_, err := parse("1").Bool()
+		Expect(err).To(MatchError("data point 1 is not a boolean"))
+	})
+	It("should read dates", func() {
+		Expect(parse(`"2021-08-12"`).Time()).To(Equal(time.Date(2021, time.August, 12, 0, 0, 0, 0, time.UTC)))
+		t, err := parse(`"2021-07-08T20:00:00Z"`).Time()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t).To(Equal(time.Date(2021, time.July, 8, 20, 0, 0, 0, time.UTC)))
+		t, err = parse("1625774400000").Time()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.Equal(time.Date(2021, time.July, 8, 20, 0, 0, 0, time.UTC))).To(BeTrue())
+		_, err = parse("true").Time()
+		Expect(err).To(MatchError("data point true is not a date"))
+	})
+	It("should handle null", func() {
+		var v Value
+		Expect(v.IsNull()).To(BeTrue())
+		Expect(parse("null").IsNull()).To(BeTrue())
+		Expect(v.Equal(parse("null"))).To(BeTrue())
+		_, err := v.Float64()
+		Expect(err).To(MatchError("data point null is not a number"))
+	})
+	It("should reject invalid JSON", func() {
+		var v Value
+		Expect(v.UnmarshalJSON([]byte("Apple"))).To(MatchError(`invalid data point: "Apple"`))
+	})
+	It("should round trip", func() {
+		v := parse(` {"a": 1} `)
+		Expect(v.Kind()).To(Equal(KindOther))
+		data, err := json.Marshal(v)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`{"a":1}`))
+		Expect(v.Equal(parse(`{"a":1}`))).To(BeTrue())
+
+		data, err = v.GobEncode()
+		Expect(err).ToNot(HaveOccurred())
+		var decoded Value
+		Expect(decoded.GobDecode(data)).To(Succeed())
+		Expect(decoded.Equal(v)).To(BeTrue())
+	})
+})
@@ -0,0 +1,45 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package fx
+
+import (
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+// GoldenRate returns golden data for the Rate type
+func GoldenRate() (r []Rate) {
+	helper.FromGolden("rate", &r)
+	return
+}
+
+// GoldenConversion returns golden data for the Conversion type
+func GoldenConversion() (c []Conversion) {
+	helper.FromGolden("conversion", &c)
+	return
+}
+
+// GoldenHistoricalRate returns golden data for the HistoricalRate type
+func GoldenHistoricalRate() (h []HistoricalRate) {
+	helper.FromGolden("historical_rate", &h)
+	return
+}
+
+// GoldenSymbols returns golden data for the Symbols type
+func GoldenSymbols() (s *Symbols) {
+	helper.FromGolden("symbols", &s)
+	return
+}
@@ -0,0 +1,104 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package fx contains the models for the foreign exchange endpoints. Rates are quoted
+// for a currency pair, whose symbol is the from currency followed by the to currency,
+// so the USDCAD rate is the number of Canadian dollars per U.S. dollar.
+// https://iexcloud.io/docs/api/#forex-currencies
+package fx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// currencyCodeLength is the length of an ISO 4217 currency code.
+const currencyCodeLength = 3
+
+// Pair represents a currency pair from the ref-data/fx/symbols endpoint.
+type Pair struct {
+	Symbol       string `json:"symbol" gorm:"primaryKey;type:character(6)"`
+	FromCurrency string `json:"fromCurrency" gorm:"type:character(3)"`
+	ToCurrency   string `json:"toCurrency" gorm:"type:character(3)"`
+}
+
+// NewPair creates the Pair that converts from one currency to another.
+// The currency codes are upper cased.
+func NewPair(from, to string) Pair {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	return Pair{Symbol: from + to, FromCurrency: from, ToCurrency: to}
+}
+
+// ParsePair splits a pair symbol, such as "USDCAD", into its currencies.
+// It will return an error if the symbol is not two three letter currency codes.
+func ParsePair(symbol string) (Pair, error) {
+	if len(symbol) != 2*currencyCodeLength {
+		return Pair{}, fmt.Errorf("invalid currency pair: %q", symbol)
+	}
+	return NewPair(symbol[:currencyCodeLength], symbol[currencyCodeLength:]), nil
+}
+
+// String returns the symbol of the Pair.
+func (p Pair) String() string {
+	return p.Symbol
+}
+
+// Inverse returns the Pair that converts in the opposite direction.
+func (p Pair) Inverse() Pair {
+	return NewPair(p.ToCurrency, p.FromCurrency)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol is not the FromCurrency followed by the ToCurrency.
+func (p *Pair) Validate() error {
+	switch {
+	case len(p.FromCurrency) != currencyCodeLength:
+		return fmt.Errorf("invalid from currency: %q", p.FromCurrency)
+	case len(p.ToCurrency) != currencyCodeLength:
+		return fmt.Errorf("invalid to currency: %q", p.ToCurrency)
+	case p.Symbol != p.FromCurrency+p.ToCurrency:
+		return fmt.Errorf("symbol %q does not match currencies", p.Symbol)
+	}
+	return nil
+}
+
+// Currency represents a currency from the ref-data/fx/symbols endpoint.
+type Currency struct {
+	Code string `json:"code" gorm:"primaryKey;type:character(3)"`
+	Name string `json:"name" gorm:"type:character varying"`
+}
+
+// Symbols represents the data returned by the ref-data/fx/symbols endpoint, which
+// lists the supported currencies and currency pairs.
+// https://iexcloud.io/docs/api/#fx-symbols
+type Symbols struct {
+	Currencies []Currency `json:"currencies"`
+	Pairs      []Pair     `json:"pairs"`
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if there are no currencies or any of the pairs are invalid.
+func (s *Symbols) Validate() error {
+	if len(s.Currencies) == 0 {
+		return fmt.Errorf("currencies are missing")
+	}
+	for idx := range s.Pairs {
+		if err := s.Pairs[idx].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
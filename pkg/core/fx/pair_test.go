@@ -0,0 +1,99 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package fx_test
+
+import (
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/fx"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Pair", func() {
+	It("should create pairs from currency codes", func() {
+		pair := NewPair("cad", "USD")
+		Expect(pair).To(Equal(Pair{Symbol: "CADUSD", FromCurrency: "CAD", ToCurrency: "USD"}))
+		Expect(pair.String()).To(Equal("CADUSD"))
+		Expect(pair.Inverse()).To(Equal(NewPair("USD", "CAD")))
+	})
+
+	It("should parse pair symbols", func() {
+		pair, err := ParsePair("EURUSD")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pair).To(Equal(NewPair("EUR", "USD")))
+		_, err = ParsePair("EURUSDX")
+		Expect(err).To(MatchError(`invalid currency pair: "EURUSDX"`))
+	})
+
+	Describe("Validate()", func() {
+		var pair Pair
+		BeforeEach(func() { pair = NewPair("EUR", "USD") })
+
+		It("should succeed if the Pair is valid", func() {
+			Expect(pair.Validate()).To(Succeed())
+		})
+		It("should return an error if a currency is invalid", func() {
+			pair.FromCurrency = "EURO"
+			Expect(pair.Validate()).To(MatchError(`invalid from currency: "EURO"`))
+			pair.FromCurrency, pair.ToCurrency = "EUR", ""
+			Expect(pair.Validate()).To(MatchError(`invalid to currency: ""`))
+		})
+		It("should return an error if the Symbol does not match", func() {
+			pair.Symbol = "USDEUR"
+			Expect(pair.Validate()).To(MatchError(`symbol "USDEUR" does not match currencies`))
+		})
+	})
+})
+
+var _ = Describe("Symbols", func() {
+	var expected *Symbols
+	BeforeEach(func() {
+		expected = &Symbols{
+			Currencies: []Currency{{Code: "CAD", Name: "Canadian Dollar"}, {Code: "USD", Name: "U.S. Dollar"}},
+			Pairs:      []Pair{NewPair("CAD", "USD"), NewPair("USD", "CAD")},
+		}
+	})
+
+	It("should parse Symbols correctly", func() {
+		var res *Symbols
+		helper.TestdataFromJSON("core/fx/symbols.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenSymbols()) {
+			helper.ToGolden("symbols", expected)
+			Fail(cmp.Diff(expected, GoldenSymbols()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Symbols are valid", func() {
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should return an error if there are no currencies", func() {
+			expected.Currencies = nil
+			Expect(expected.Validate()).To(MatchError("currencies are missing"))
+		})
+		It("should return an error if a pair is invalid", func() {
+			expected.Pairs[1].ToCurrency = "EUR"
+			Expect(expected.Validate()).To(MatchError(`symbol "USDCAD" does not match currencies`))
+		})
+	})
+})
@@ -0,0 +1,212 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package fx
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Rate represents a data point from the Latest Currency Rates endpoint.
+// https://iexcloud.io/docs/api/#latest-currency-rates
+type Rate struct {
+	Symbol    string    `json:"symbol" gorm:"primaryKey;type:character(6)"`
+	Rate      float64   `json:"rate" gorm:"type:double precision"`
+	Timestamp time.Time `json:"-" gorm:"primaryKey"`
+	// IsDerived is true if IEX calculated the rate from other pairs.
+	IsDerived bool `json:"isDerived"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the timestamp field, which is specified in
+// milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (r *Rate) UnmarshalJSON(data []byte) (err error) {
+	type rate Rate
+	type embedded struct {
+		rate
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*r = Rate(tmp.rate)
+		if tmp.Timestamp > 0 {
+			r.Timestamp = time.Unix(tmp.Timestamp/1000, tmp.Timestamp%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (r *Rate) MarshalJSON() ([]byte, error) {
+	type rate Rate
+	type embedded struct {
+		rate
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.rate = rate(*r)
+	if !r.Timestamp.IsZero() {
+		tmp.Timestamp = r.Timestamp.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Pair returns the currency pair of the Rate.
+func (r *Rate) Pair() (Pair, error) {
+	return ParsePair(r.Symbol)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol is not a currency pair or the Rate is not positive.
+func (r *Rate) Validate() error {
+	if _, err := r.Pair(); err != nil {
+		return err
+	}
+	if r.Rate <= 0 {
+		return fmt.Errorf("rate is not positive")
+	}
+	return nil
+}
+
+// Conversion represents a data point from the Currency Conversion endpoint, which
+// converts an amount at the latest rate.
+// https://iexcloud.io/docs/api/#currency-conversion
+type Conversion struct {
+	Symbol    string    `json:"symbol" gorm:"primaryKey;type:character(6)"`
+	Rate      float64   `json:"rate" gorm:"type:double precision"`
+	Timestamp time.Time `json:"-" gorm:"primaryKey"`
+	IsDerived bool      `json:"isDerived"`
+	// Amount is the requested amount in the to currency.
+	Amount float64 `json:"amount" gorm:"type:double precision"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the timestamp field, which is specified in
+// milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (c *Conversion) UnmarshalJSON(data []byte) (err error) {
+	type conversion Conversion
+	type embedded struct {
+		conversion
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*c = Conversion(tmp.conversion)
+		if tmp.Timestamp > 0 {
+			c.Timestamp = time.Unix(tmp.Timestamp/1000, tmp.Timestamp%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (c *Conversion) MarshalJSON() ([]byte, error) {
+	type conversion Conversion
+	type embedded struct {
+		conversion
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.conversion = conversion(*c)
+	if !c.Timestamp.IsZero() {
+		tmp.Timestamp = c.Timestamp.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol is not a currency pair or the Rate is not positive.
+func (c *Conversion) Validate() error {
+	if _, err := ParsePair(c.Symbol); err != nil {
+		return err
+	}
+	if c.Rate <= 0 {
+		return fmt.Errorf("rate is not positive")
+	}
+	return nil
+}
+
+// HistoricalRate represents a data point from the Historical Daily endpoint.
+// https://iexcloud.io/docs/api/#historical-daily
+type HistoricalRate struct {
+	Date      time.Time `json:"-" gorm:"primaryKey;type:date"`
+	Symbol    string    `json:"symbol" gorm:"primaryKey;type:character(6)"`
+	Rate      float64   `json:"rate" gorm:"type:double precision"`
+	Timestamp time.Time `json:"-"`
+	IsDerived bool      `json:"isDerived"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date field, which is specified as "YYYY-MM-DD",
+// and the timestamp field, which is specified in milliseconds since the epoch, into time.Times.
+// It will return an error if the JSON cannot be unmarshaled, but NOT if the date parsing fails.
+func (h *HistoricalRate) UnmarshalJSON(data []byte) (err error) {
+	type historicalRate HistoricalRate
+	type embedded struct {
+		historicalRate
+		Date      string `json:"date"`
+		Timestamp int64  `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*h = HistoricalRate(tmp.historicalRate)
+		h.Date, _ = time.Parse("2006-01-02", tmp.Date) // nolint:errcheck
+		if tmp.Timestamp > 0 {
+			h.Timestamp = time.Unix(tmp.Timestamp/1000, tmp.Timestamp%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (h *HistoricalRate) MarshalJSON() ([]byte, error) {
+	type historicalRate HistoricalRate
+	type embedded struct {
+		historicalRate
+		Date      string `json:"date"`
+		Timestamp int64  `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.historicalRate = historicalRate(*h)
+	tmp.Date = h.Date.Format("2006-01-02")
+	if !h.Timestamp.IsZero() {
+		tmp.Timestamp = h.Timestamp.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Date is missing, the Symbol is not a currency pair,
+// or the Rate is not positive.
+func (h *HistoricalRate) Validate() error {
+	if h.Date.IsZero() {
+		return fmt.Errorf("date is missing")
+	}
+	if _, err := ParsePair(h.Symbol); err != nil {
+		return err
+	}
+	if h.Rate <= 0 {
+		return fmt.Errorf("rate is not positive")
+	}
+	return nil
+}
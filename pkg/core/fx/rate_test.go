@@ -0,0 +1,162 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package fx_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/fx"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Rate", func() {
+	ts := time.Date(2021, time.July, 8, 20, 0, 0, 0, time.UTC)
+
+	Describe("Rate", func() {
+		var expected []Rate
+		BeforeEach(func() {
+			expected = []Rate{
+				{Symbol: "USDCAD", Rate: 1.2498, Timestamp: ts},
+				{Symbol: "CADUSD", Rate: 0.80013, Timestamp: ts, IsDerived: true},
+			}
+		})
+
+		It("should parse Rates correctly", func() {
+			var res []Rate
+			helper.TestdataFromJSON("core/fx/rate.json", &res)
+			Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+		})
+
+		It("should have a current golden file", func() {
+			if !cmp.Equal(expected, GoldenRate()) {
+				helper.ToGolden("rate", expected)
+				Fail(cmp.Diff(expected, GoldenRate()))
+			}
+		})
+
+		It("should return its currency pair", func() {
+			pair, err := expected[1].Pair()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pair).To(Equal(Pair{Symbol: "CADUSD", FromCurrency: "CAD", ToCurrency: "USD"}))
+		})
+
+		Describe("Validate()", func() {
+			It("should succeed if the Rate is valid", func() {
+				Expect(expected[0].Validate()).To(Succeed())
+			})
+			It("should return an error if the Symbol is not a pair", func() {
+				expected[0].Symbol = "USD"
+				Expect(expected[0].Validate()).To(MatchError(`invalid currency pair: "USD"`))
+			})
+			It("should return an error if the Rate is not positive", func() {
+				expected[0].Rate = 0
+				Expect(expected[0].Validate()).To(MatchError("rate is not positive"))
+			})
+		})
+	})
+
+	Describe("Conversion", func() {
+		var expected []Conversion
+		BeforeEach(func() {
+			expected = []Conversion{{Symbol: "USDCAD", Rate: 1.2498, Timestamp: ts, Amount: 124.98}}
+		})
+
+		It("should parse Conversions correctly", func() {
+			var res []Conversion
+			helper.TestdataFromJSON("core/fx/conversion.json", &res)
+			Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+		})
+
+		It("should have a current golden file", func() {
+			if !cmp.Equal(expected, GoldenConversion()) {
+				helper.ToGolden("conversion", expected)
+				Fail(cmp.Diff(expected, GoldenConversion()))
+			}
+		})
+
+		Describe("Validate()", func() {
+			It("should succeed if the Conversion is valid", func() {
+				Expect(expected[0].Validate()).To(Succeed())
+			})
+			It("should return an error if the Rate is not positive", func() {
+				expected[0].Rate = -1
+				Expect(expected[0].Validate()).To(MatchError("rate is not positive"))
+			})
+		})
+	})
+
+	Describe("HistoricalRate", func() {
+		var expected []HistoricalRate
+		BeforeEach(func() {
+			expected = []HistoricalRate{{
+				Date:      time.Date(2021, time.July, 7, 0, 0, 0, 0, time.UTC),
+				Symbol:    "CADUSD",
+				Rate:      0.80192,
+				Timestamp: ts.AddDate(0, 0, -1),
+				IsDerived: true,
+			}, {
+				Date:      time.Date(2021, time.July, 8, 0, 0, 0, 0, time.UTC),
+				Symbol:    "CADUSD",
+				Rate:      0.80013,
+				Timestamp: ts,
+				IsDerived: true,
+			}, {
+				Date:      time.Date(2021, time.July, 8, 0, 0, 0, 0, time.UTC),
+				Symbol:    "EURUSD",
+				Rate:      1.1843,
+				Timestamp: ts,
+			}}
+		})
+
+		It("should parse HistoricalRates correctly", func() {
+			var res [][]HistoricalRate
+			helper.TestdataFromJSON("core/fx/historical_rate.json", &res)
+			Expect(res).To(HaveLen(2))
+			flat := append(res[0], res[1]...)
+			Expect(cmp.Equal(expected, flat)).To(BeTrue(), cmp.Diff(expected, flat))
+		})
+
+		It("should have a current golden file", func() {
+			if !cmp.Equal(expected, GoldenHistoricalRate()) {
+				helper.ToGolden("historical_rate", expected)
+				Fail(cmp.Diff(expected, GoldenHistoricalRate()))
+			}
+		})
+
+		Describe("Validate()", func() {
+			It("should succeed if the HistoricalRate is valid", func() {
+				Expect(expected[0].Validate()).To(Succeed())
+			})
+			It("should return an error if the Date is missing", func() {
+				expected[0].Date = time.Time{}
+				Expect(expected[0].Validate()).To(MatchError("date is missing"))
+			})
+			It("should return an error if the Symbol is not a pair", func() {
+				expected[0].Symbol = ""
+				Expect(expected[0].Validate()).To(MatchError(`invalid currency pair: ""`))
+			})
+			It("should return an error if the Rate is not positive", func() {
+				expected[0].Rate = 0
+				Expect(expected[0].Validate()).To(MatchError("rate is not positive"))
+			})
+		})
+	})
+})
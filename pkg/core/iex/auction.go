@@ -0,0 +1,141 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package iex
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Auction represents the data returned by the DEEP Auction endpoint for a symbol
+// while an IEX auction is in progress.
+// https://iexcloud.io/docs/api/#deep-auction
+type Auction struct {
+	AuctionType          AuctionType   `json:"auctionType"`
+	PairedShares         int64         `json:"pairedShares"`
+	ImbalanceShares      int64         `json:"imbalanceShares"`
+	ImbalanceSide        ImbalanceSide `json:"imbalanceSide,omitempty"`
+	ReferencePrice       float64       `json:"referencePrice"`
+	IndicativePrice      float64       `json:"indicativePrice"`
+	AuctionBookPrice     float64       `json:"auctionBookPrice"`
+	CollarReferencePrice float64       `json:"collarReferencePrice"`
+	LowerCollarPrice     float64       `json:"lowerCollarPrice"`
+	UpperCollarPrice     float64       `json:"upperCollarPrice"`
+	ExtensionNumber      int           `json:"extensionNumber"`
+	// StartTime is the scheduled time of the auction as HH:MM:SS in America/New_York.
+	StartTime  string    `json:"startTime"`
+	LastUpdate time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the lastUpdate field, which is specified in
+// milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (a *Auction) UnmarshalJSON(data []byte) (err error) {
+	type auction Auction
+	type embedded struct {
+		auction
+		LastUpdate int64 `json:"lastUpdate,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*a = Auction(tmp.auction)
+		a.LastUpdate = fromMillis(tmp.LastUpdate)
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+// It has a value receiver because the endpoint returns auctions in a map.
+func (a Auction) MarshalJSON() ([]byte, error) {
+	type auction Auction
+	type embedded struct {
+		auction
+		LastUpdate int64 `json:"lastUpdate,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.auction = auction(a)
+	tmp.LastUpdate = toMillis(a.LastUpdate)
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the AuctionType or ImbalanceSide is invalid.
+func (a *Auction) Validate() error {
+	switch {
+	case !a.AuctionType.IsValid():
+		return fmt.Errorf("invalid auction type: %q", a.AuctionType)
+	case a.ImbalanceSide != "" && !a.ImbalanceSide.IsValid():
+		return fmt.Errorf("invalid imbalance side: %q", a.ImbalanceSide)
+	}
+	return nil
+}
+
+// OfficialPrice represents the data returned by the DEEP Official Price endpoint:
+// the official IEX opening or closing price of a symbol.
+// https://iexcloud.io/docs/api/#deep-official-price
+type OfficialPrice struct {
+	PriceType PriceType `json:"priceType"`
+	Price     float64   `json:"price"`
+	Timestamp time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the timestamp field, which is specified in
+// milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (p *OfficialPrice) UnmarshalJSON(data []byte) (err error) {
+	type officialPrice OfficialPrice
+	type embedded struct {
+		officialPrice
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*p = OfficialPrice(tmp.officialPrice)
+		p.Timestamp = fromMillis(tmp.Timestamp)
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (p OfficialPrice) MarshalJSON() ([]byte, error) {
+	type officialPrice OfficialPrice
+	type embedded struct {
+		officialPrice
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.officialPrice = officialPrice(p)
+	tmp.Timestamp = toMillis(p.Timestamp)
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the PriceType is invalid or the Price is zero.
+func (p *OfficialPrice) Validate() error {
+	switch {
+	case !p.PriceType.IsValid():
+		return fmt.Errorf("invalid price type: %q", p.PriceType)
+	case p.Price == 0:
+		return fmt.Errorf("price is zero")
+	}
+	return nil
+}
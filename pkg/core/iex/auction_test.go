@@ -0,0 +1,123 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package iex_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/iex"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Auction", func() {
+	var expected map[string]Auction
+	BeforeEach(func() {
+		expected = map[string]Auction{"ZIEXT": {
+			AuctionType:          AuctionClose,
+			PairedShares:         3600,
+			ImbalanceShares:      600,
+			ImbalanceSide:        ImbalanceBuy,
+			ReferencePrice:       1.05,
+			IndicativePrice:      1.05,
+			AuctionBookPrice:     1.05,
+			CollarReferencePrice: 1.05,
+			LowerCollarPrice:     0.59,
+			UpperCollarPrice:     1.59,
+			StartTime:            "16:00:00",
+			LastUpdate:           time.Date(2021, time.July, 8, 19, 50, 0, 394e6, time.UTC),
+		}}
+	})
+
+	It("should parse auctions correctly", func() {
+		var res map[string]Auction
+		helper.TestdataFromJSON("core/iex/deep_auction.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenAuction()) {
+			helper.ToGolden("deep_auction", expected)
+			Fail(cmp.Diff(expected, GoldenAuction()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		var a Auction
+		BeforeEach(func() { a = expected["ZIEXT"] })
+
+		It("should succeed if the Auction is valid", func() {
+			Expect(a.Validate()).To(Succeed())
+		})
+		It("should succeed if the ImbalanceSide is missing", func() {
+			a.ImbalanceSide = ""
+			Expect(a.Validate()).To(Succeed())
+		})
+		It("should return an error if the AuctionType is invalid", func() {
+			a.AuctionType = "Midday"
+			Expect(a.Validate()).To(MatchError(`invalid auction type: "Midday"`))
+		})
+		It("should return an error if the ImbalanceSide is invalid", func() {
+			a.ImbalanceSide = "Both"
+			Expect(a.Validate()).To(MatchError(`invalid imbalance side: "Both"`))
+		})
+	})
+})
+
+var _ = Describe("OfficialPrice", func() {
+	var expected map[string]OfficialPrice
+	BeforeEach(func() {
+		expected = map[string]OfficialPrice{"AAPL": {
+			PriceType: PriceTypeClose,
+			Price:     144.57,
+			Timestamp: time.Date(2021, time.July, 8, 20, 0, 0, 412e6, time.UTC),
+		}}
+	})
+
+	It("should parse official prices correctly", func() {
+		var res map[string]OfficialPrice
+		helper.TestdataFromJSON("core/iex/deep_official_price.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenOfficialPrice()) {
+			helper.ToGolden("deep_official_price", expected)
+			Fail(cmp.Diff(expected, GoldenOfficialPrice()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		var p OfficialPrice
+		BeforeEach(func() { p = expected["AAPL"] })
+
+		It("should succeed if the OfficialPrice is valid", func() {
+			Expect(p.Validate()).To(Succeed())
+		})
+		It("should return an error if the PriceType is invalid", func() {
+			p.PriceType = "Midday"
+			Expect(p.Validate()).To(MatchError(`invalid price type: "Midday"`))
+		})
+		It("should return an error if the Price is zero", func() {
+			p.Price = 0
+			Expect(p.Validate()).To(MatchError("price is zero"))
+		})
+	})
+})
@@ -0,0 +1,119 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package iex
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+)
+
+// DEEP represents the data returned by the DEEP endpoint for a single symbol: the
+// aggregated IEX book, the latest trades, and the status of the security.
+// https://iexcloud.io/docs/api/#deep
+type DEEP struct {
+	Symbol        string            `json:"symbol"`
+	MarketPercent float64           `json:"marketPercent"`
+	Volume        int64             `json:"volume"`
+	LastSalePrice float64           `json:"lastSalePrice"`
+	LastSaleSize  int64             `json:"lastSaleSize"`
+	LastSaleTime  time.Time         `json:"-"`
+	LastUpdated   time.Time         `json:"-"`
+	Bids          []stock.BookLevel `json:"bids"`
+	Asks          []stock.BookLevel `json:"asks"`
+	SystemEvent   *SystemEvent      `json:"systemEvent,omitempty"`
+	TradingStatus *TradingStatus    `json:"tradingStatus,omitempty"`
+	OpHaltStatus  *OpHaltStatus     `json:"opHaltStatus,omitempty"`
+	SSRStatus     *SSRStatus        `json:"ssrStatus,omitempty"`
+	SecurityEvent *SecurityEvent    `json:"securityEvent,omitempty"`
+	Trades        []stock.Trade     `json:"trades"`
+	TradeBreaks   []stock.Trade     `json:"tradeBreaks"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the lastSaleTime and lastUpdated fields, which are
+// specified in milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (d *DEEP) UnmarshalJSON(data []byte) (err error) {
+	type deep DEEP
+	type embedded struct {
+		deep
+		LastSaleTime int64 `json:"lastSaleTime,omitempty"`
+		LastUpdated  int64 `json:"lastUpdated,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*d = DEEP(tmp.deep)
+		d.LastSaleTime = fromMillis(tmp.LastSaleTime)
+		d.LastUpdated = fromMillis(tmp.LastUpdated)
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (d *DEEP) MarshalJSON() ([]byte, error) {
+	type deep DEEP
+	type embedded struct {
+		deep
+		LastSaleTime int64 `json:"lastSaleTime"`
+		LastUpdated  int64 `json:"lastUpdated"`
+	}
+	tmp := new(embedded)
+	tmp.deep = deep(*d)
+	tmp.LastSaleTime = toMillis(d.LastSaleTime)
+	tmp.LastUpdated = toMillis(d.LastUpdated)
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol is missing or the TradingStatus is invalid.
+func (d *DEEP) Validate() error {
+	switch {
+	case d.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case d.TradingStatus != nil:
+		return d.TradingStatus.Validate()
+	}
+	return nil
+}
+
+// Book represents the IEX bids and asks for a symbol from the DEEP Book endpoint.
+// https://iexcloud.io/docs/api/#deep-book
+type Book struct {
+	Bids []stock.BookLevel `json:"bids"`
+	Asks []stock.BookLevel `json:"asks"`
+}
+
+// fromMillis converts milliseconds since the epoch into a time.Time.
+// Zero and negative values, which IEX sends when there is no time, become the zero time.
+func fromMillis(ms int64) time.Time {
+	if ms <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(ms/1000, ms%1000*1e6) // nolint:gomnd
+}
+
+// toMillis undoes what fromMillis does.
+func toMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano() / 1e6 // nolint:gomnd
+}
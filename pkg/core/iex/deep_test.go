@@ -0,0 +1,100 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package iex_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/iex"
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("DEEP", func() {
+	var expected *DEEP
+	BeforeEach(func() {
+		updated := time.Date(2021, time.July, 8, 19, 59, 58, 980e6, time.UTC)
+		lastSale := time.Date(2021, time.July, 8, 19, 59, 57, 355e6, time.UTC)
+		open := time.Date(2021, time.July, 8, 13, 30, 0, 0, time.UTC)
+		premarket := time.Date(2021, time.July, 8, 8, 0, 0, 125e6, time.UTC)
+		expected = &DEEP{
+			Symbol:        "AAPL",
+			MarketPercent: 0.02406,
+			Volume:        2539842,
+			LastSalePrice: 144.57,
+			LastSaleSize:  20,
+			LastSaleTime:  lastSale,
+			LastUpdated:   updated,
+			Bids:          []stock.BookLevel{{Price: 144.55, Size: 100, Timestamp: updated}},
+			Asks:          []stock.BookLevel{{Price: 144.58, Size: 200, Timestamp: updated}},
+			SystemEvent:   &SystemEvent{SystemEvent: SystemEventStartOfRegularHours, Timestamp: open},
+			TradingStatus: &TradingStatus{Status: TradingStatusTrading, Reason: ReasonNone, Timestamp: premarket},
+			OpHaltStatus:  &OpHaltStatus{Timestamp: premarket},
+			SSRStatus:     &SSRStatus{Detail: SSRDetailNone, Timestamp: premarket},
+			SecurityEvent: &SecurityEvent{SecurityEvent: SecurityEventMarketOpen, Timestamp: open},
+			Trades: []stock.Trade{{
+				Price:     144.57,
+				Size:      20,
+				TradeID:   2030142875,
+				IsOddLot:  true,
+				Timestamp: lastSale,
+			}},
+			TradeBreaks: []stock.Trade{{
+				Price:     144.2,
+				Size:      100,
+				TradeID:   2030140001,
+				IsISO:     true,
+				Timestamp: time.Date(2021, time.July, 8, 19, 55, 0, 0, time.UTC),
+			}},
+		}
+	})
+
+	It("should parse DEEP correctly", func() {
+		var res *DEEP
+		helper.TestdataFromJSON("core/iex/deep.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenDEEP()) {
+			helper.ToGolden("deep", expected)
+			Fail(cmp.Diff(expected, GoldenDEEP()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the DEEP is valid", func() {
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should succeed if the TradingStatus is missing", func() {
+			expected.TradingStatus = nil
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should return an error if the Symbol is missing", func() {
+			expected.Symbol = ""
+			Expect(expected.Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the TradingStatus is invalid", func() {
+			expected.TradingStatus.Status = "X"
+			Expect(expected.Validate()).To(MatchError(`invalid trading status: "X"`))
+		})
+	})
+})
@@ -0,0 +1,316 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package iex
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SystemEventCode identifies a DEEP system event, which marks a point in the trading day.
+// https://iexcloud.io/docs/api/#deep-system-event
+type SystemEventCode string
+
+const (
+	SystemEventStartOfMessages     SystemEventCode = "O"
+	SystemEventStartOfSystemHours  SystemEventCode = "S"
+	SystemEventStartOfRegularHours SystemEventCode = "R"
+	SystemEventEndOfRegularHours   SystemEventCode = "M"
+	SystemEventEndOfSystemHours    SystemEventCode = "E"
+	SystemEventEndOfMessages       SystemEventCode = "C"
+)
+
+// IsValid returns true if the SystemEventCode is one of the values documented by IEX.
+func (c SystemEventCode) IsValid() bool {
+	switch c {
+	case SystemEventStartOfMessages, SystemEventStartOfSystemHours, SystemEventStartOfRegularHours,
+		SystemEventEndOfRegularHours, SystemEventEndOfSystemHours, SystemEventEndOfMessages:
+		return true
+	}
+	return false
+}
+
+func (c *SystemEventCode) Scan(value interface{}) (err error) {
+	var s string
+	if s, err = scanString(value, "SystemEventCode"); err == nil {
+		*c = SystemEventCode(s)
+	}
+	return
+}
+
+func (c SystemEventCode) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+// TradingStatusCode is the trading status of a security on IEX.
+// https://iexcloud.io/docs/api/#deep-trading-status
+type TradingStatusCode string
+
+const (
+	TradingStatusHalted          TradingStatusCode = "H" // halted across all U.S. equity markets
+	TradingStatusOrderAcceptance TradingStatusCode = "O" // halted, but accepting orders on IEX
+	TradingStatusPaused          TradingStatusCode = "P" // paused on IEX only
+	TradingStatusTrading         TradingStatusCode = "T" // trading on IEX
+)
+
+// IsValid returns true if the TradingStatusCode is one of the values documented by IEX.
+func (c TradingStatusCode) IsValid() bool {
+	switch c {
+	case TradingStatusHalted, TradingStatusOrderAcceptance, TradingStatusPaused, TradingStatusTrading:
+		return true
+	}
+	return false
+}
+
+func (c *TradingStatusCode) Scan(value interface{}) (err error) {
+	var s string
+	if s, err = scanString(value, "TradingStatusCode"); err == nil {
+		*c = TradingStatusCode(s)
+	}
+	return
+}
+
+func (c TradingStatusCode) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+// ReasonCode is the reason for a halt or order acceptance period in a TradingStatus.
+// IEX pads the reason with spaces when there is none, which is unmarshaled as ReasonNone.
+// https://iexcloud.io/docs/api/#deep-trading-status
+type ReasonCode string
+
+const (
+	ReasonNone ReasonCode = ""
+
+	// Trading halt reasons
+	ReasonHaltNewsPending        ReasonCode = "T1"
+	ReasonIPONotYetTrading       ReasonCode = "IPO1"
+	ReasonIPODeferred            ReasonCode = "IPOD"
+	ReasonMarketCircuitBreakerL3 ReasonCode = "MCB3"
+	ReasonNotAvailable           ReasonCode = "NA"
+
+	// Order acceptance period reasons
+	ReasonHaltNewsDissemination  ReasonCode = "T2"
+	ReasonIPOOrderAcceptance     ReasonCode = "IPO2"
+	ReasonIPOPreLaunch           ReasonCode = "IPO3"
+	ReasonMarketCircuitBreakerL1 ReasonCode = "MCB1"
+	ReasonMarketCircuitBreakerL2 ReasonCode = "MCB2"
+)
+
+// IsValid returns true if the ReasonCode is one of the values documented by IEX.
+func (c ReasonCode) IsValid() bool {
+	switch c {
+	case ReasonNone, ReasonHaltNewsPending, ReasonIPONotYetTrading, ReasonIPODeferred,
+		ReasonMarketCircuitBreakerL3, ReasonNotAvailable, ReasonHaltNewsDissemination,
+		ReasonIPOOrderAcceptance, ReasonIPOPreLaunch, ReasonMarketCircuitBreakerL1,
+		ReasonMarketCircuitBreakerL2:
+		return true
+	}
+	return false
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// Surrounding spaces are trimmed, and null leaves the ReasonCode unchanged.
+func (c *ReasonCode) UnmarshalJSON(data []byte) (err error) {
+	var tmp *string
+	if err = json.Unmarshal(data, &tmp); err == nil && tmp != nil {
+		*c = ReasonCode(strings.TrimSpace(*tmp))
+	}
+	return
+}
+
+func (c *ReasonCode) Scan(value interface{}) (err error) {
+	var s string
+	if s, err = scanString(value, "ReasonCode"); err == nil {
+		*c = ReasonCode(s)
+	}
+	return
+}
+
+func (c ReasonCode) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+// SSRDetail is the detail of a short sale price test restriction status.
+// IEX sends a space when there is no detail, which is unmarshaled as SSRDetailNone.
+// https://iexcloud.io/docs/api/#deep-short-sale-price-test-status
+type SSRDetail string
+
+const (
+	SSRDetailNone         SSRDetail = ""
+	SSRDetailActivated    SSRDetail = "A" // activated intraday due to a price drop
+	SSRDetailContinued    SSRDetail = "C" // continued from the prior day
+	SSRDetailDeactivated  SSRDetail = "D"
+	SSRDetailNotAvailable SSRDetail = "N"
+)
+
+// IsValid returns true if the SSRDetail is one of the values documented by IEX.
+func (d SSRDetail) IsValid() bool {
+	switch d {
+	case SSRDetailNone, SSRDetailActivated, SSRDetailContinued, SSRDetailDeactivated, SSRDetailNotAvailable:
+		return true
+	}
+	return false
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// Surrounding spaces are trimmed, and null leaves the SSRDetail unchanged.
+func (d *SSRDetail) UnmarshalJSON(data []byte) (err error) {
+	var tmp *string
+	if err = json.Unmarshal(data, &tmp); err == nil && tmp != nil {
+		*d = SSRDetail(strings.TrimSpace(*tmp))
+	}
+	return
+}
+
+func (d *SSRDetail) Scan(value interface{}) (err error) {
+	var s string
+	if s, err = scanString(value, "SSRDetail"); err == nil {
+		*d = SSRDetail(s)
+	}
+	return
+}
+
+func (d SSRDetail) Value() (driver.Value, error) {
+	return string(d), nil
+}
+
+// SecurityEventType identifies a DEEP security event.
+// https://iexcloud.io/docs/api/#deep-security-event
+type SecurityEventType string
+
+const (
+	SecurityEventMarketOpen  SecurityEventType = "MarketOpen"
+	SecurityEventMarketClose SecurityEventType = "MarketClose"
+)
+
+// IsValid returns true if the SecurityEventType is one of the values documented by IEX.
+func (t SecurityEventType) IsValid() bool {
+	return t == SecurityEventMarketOpen || t == SecurityEventMarketClose
+}
+
+func (t *SecurityEventType) Scan(value interface{}) (err error) {
+	var s string
+	if s, err = scanString(value, "SecurityEventType"); err == nil {
+		*t = SecurityEventType(s)
+	}
+	return
+}
+
+func (t SecurityEventType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// AuctionType is the type of an IEX auction.
+// https://iexcloud.io/docs/api/#deep-auction
+type AuctionType string
+
+const (
+	AuctionOpen       AuctionType = "Open"
+	AuctionClose      AuctionType = "Close"
+	AuctionIPO        AuctionType = "IPO"
+	AuctionHalt       AuctionType = "Halt"
+	AuctionVolatility AuctionType = "Volatility"
+)
+
+// IsValid returns true if the AuctionType is one of the values documented by IEX.
+func (t AuctionType) IsValid() bool {
+	switch t {
+	case AuctionOpen, AuctionClose, AuctionIPO, AuctionHalt, AuctionVolatility:
+		return true
+	}
+	return false
+}
+
+func (t *AuctionType) Scan(value interface{}) (err error) {
+	var s string
+	if s, err = scanString(value, "AuctionType"); err == nil {
+		*t = AuctionType(s)
+	}
+	return
+}
+
+func (t AuctionType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// ImbalanceSide is the side of the imbalance in an Auction.
+type ImbalanceSide string
+
+const (
+	ImbalanceBuy  ImbalanceSide = "Buy"
+	ImbalanceSell ImbalanceSide = "Sell"
+	ImbalanceNone ImbalanceSide = "No Imbalance"
+)
+
+// IsValid returns true if the ImbalanceSide is one of the values documented by IEX.
+func (s ImbalanceSide) IsValid() bool {
+	return s == ImbalanceBuy || s == ImbalanceSell || s == ImbalanceNone
+}
+
+func (s *ImbalanceSide) Scan(value interface{}) (err error) {
+	var str string
+	if str, err = scanString(value, "ImbalanceSide"); err == nil {
+		*s = ImbalanceSide(str)
+	}
+	return
+}
+
+func (s ImbalanceSide) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// PriceType is the type of an IEX OfficialPrice.
+// https://iexcloud.io/docs/api/#deep-official-price
+type PriceType string
+
+const (
+	PriceTypeOpen  PriceType = "Open"
+	PriceTypeClose PriceType = "Close"
+)
+
+// IsValid returns true if the PriceType is one of the values documented by IEX.
+func (t PriceType) IsValid() bool {
+	return t == PriceTypeOpen || t == PriceTypeClose
+}
+
+func (t *PriceType) Scan(value interface{}) (err error) {
+	var s string
+	if s, err = scanString(value, "PriceType"); err == nil {
+		*t = PriceType(s)
+	}
+	return
+}
+
+func (t PriceType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// scanString converts a value read from a database into a string for the named type.
+func scanString(value interface{}, name string) (string, error) {
+	switch v := value.(type) {
+	case []byte:
+		return string(v), nil
+	case string:
+		return v, nil
+	case nil:
+		return "", nil
+	}
+	return "", fmt.Errorf("cannot scan %T into %s", value, name)
+}
@@ -0,0 +1,93 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package iex_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/iex"
+)
+
+var _ = Describe("DEEP enums", func() {
+	Describe("ReasonCode", func() {
+		It("should trim the padding IEX sends", func() {
+			var r ReasonCode
+			Expect(json.Unmarshal([]byte(`"    "`), &r)).To(Succeed())
+			Expect(r).To(Equal(ReasonNone))
+			Expect(json.Unmarshal([]byte(`"MCB1"`), &r)).To(Succeed())
+			Expect(r).To(Equal(ReasonMarketCircuitBreakerL1))
+		})
+		It("should leave the value unchanged for null", func() {
+			r := ReasonNotAvailable
+			Expect(json.Unmarshal([]byte(`null`), &r)).To(Succeed())
+			Expect(r).To(Equal(ReasonNotAvailable))
+		})
+		It("should validate reason codes", func() {
+			Expect(ReasonIPODeferred.IsValid()).To(BeTrue())
+			Expect(ReasonCode("T3").IsValid()).To(BeFalse())
+		})
+	})
+
+	Describe("SSRDetail", func() {
+		It("should trim the space IEX sends when there is no detail", func() {
+			var d SSRDetail
+			Expect(json.Unmarshal([]byte(`" "`), &d)).To(Succeed())
+			Expect(d).To(Equal(SSRDetailNone))
+			Expect(d.IsValid()).To(BeTrue())
+		})
+	})
+
+	Describe("Scan()", func() {
+		It("should scan strings and bytes", func() {
+			var c TradingStatusCode
+			Expect(c.Scan([]byte("H"))).To(Succeed())
+			Expect(c).To(Equal(TradingStatusHalted))
+			var t AuctionType
+			Expect(t.Scan("IPO")).To(Succeed())
+			Expect(t).To(Equal(AuctionIPO))
+			var s ImbalanceSide
+			Expect(s.Scan(nil)).To(Succeed())
+			Expect(s).To(BeEmpty())
+		})
+		It("should return an error for other types", func() {
+			var p PriceType
+			Expect(p.Scan(42)).To(MatchError("cannot scan int into PriceType"))
+		})
+	})
+
+	Describe("Value()", func() {
+		It("should return the string value", func() {
+			Expect(SystemEventEndOfRegularHours.Value()).To(Equal("M"))
+			Expect(SecurityEventMarketOpen.Value()).To(Equal("MarketOpen"))
+		})
+	})
+
+	Describe("IsValid()", func() {
+		It("should reject unknown values", func() {
+			Expect(SystemEventCode("X").IsValid()).To(BeFalse())
+			Expect(TradingStatusCode("X").IsValid()).To(BeFalse())
+			Expect(SSRDetail("X").IsValid()).To(BeFalse())
+			Expect(SecurityEventType("X").IsValid()).To(BeFalse())
+			Expect(AuctionType("X").IsValid()).To(BeFalse())
+			Expect(ImbalanceSide("X").IsValid()).To(BeFalse())
+			Expect(PriceType("X").IsValid()).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,279 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package iex
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SystemEvent represents the data returned by the DEEP System Event endpoint.
+// https://iexcloud.io/docs/api/#deep-system-event
+type SystemEvent struct {
+	SystemEvent SystemEventCode `json:"systemEvent"`
+	Timestamp   time.Time       `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the timestamp field, which is specified in
+// milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (e *SystemEvent) UnmarshalJSON(data []byte) (err error) {
+	type systemEvent SystemEvent
+	type embedded struct {
+		systemEvent
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*e = SystemEvent(tmp.systemEvent)
+		e.Timestamp = fromMillis(tmp.Timestamp)
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+// The DEEP events have value receivers because the endpoints return them in maps,
+// whose values are not addressable.
+func (e SystemEvent) MarshalJSON() ([]byte, error) {
+	type systemEvent SystemEvent
+	type embedded struct {
+		systemEvent
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.systemEvent = systemEvent(e)
+	tmp.Timestamp = toMillis(e.Timestamp)
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the SystemEvent is invalid or the Timestamp is missing.
+func (e *SystemEvent) Validate() error {
+	switch {
+	case !e.SystemEvent.IsValid():
+		return fmt.Errorf("invalid system event: %q", e.SystemEvent)
+	case e.Timestamp.IsZero():
+		return fmt.Errorf("timestamp is missing")
+	}
+	return nil
+}
+
+// TradingStatus represents the trading status of a security on IEX, from the
+// DEEP Trading Status endpoint or embedded in DEEP.
+// https://iexcloud.io/docs/api/#deep-trading-status
+type TradingStatus struct {
+	Status    TradingStatusCode `json:"status"`
+	Reason    ReasonCode        `json:"reason"`
+	Timestamp time.Time         `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the timestamp or updated field, whichever is present,
+// which is specified in milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (s *TradingStatus) UnmarshalJSON(data []byte) (err error) {
+	type tradingStatus TradingStatus
+	type embedded struct {
+		tradingStatus
+		Timestamp int64 `json:"timestamp,omitempty"`
+		Updated   int64 `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*s = TradingStatus(tmp.tradingStatus)
+		ms := tmp.Timestamp
+		if ms == 0 {
+			ms = tmp.Updated
+		}
+		s.Timestamp = fromMillis(ms)
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does, always writing the time to the "timestamp" field.
+func (s TradingStatus) MarshalJSON() ([]byte, error) {
+	type tradingStatus TradingStatus
+	type embedded struct {
+		tradingStatus
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.tradingStatus = tradingStatus(s)
+	tmp.Timestamp = toMillis(s.Timestamp)
+	return json.Marshal(tmp)
+}
+
+// IsHalted returns true if trading in the security is halted or paused.
+func (s *TradingStatus) IsHalted() bool {
+	return s.Status == TradingStatusHalted || s.Status == TradingStatusPaused ||
+		s.Status == TradingStatusOrderAcceptance
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Status or Reason is invalid.
+func (s *TradingStatus) Validate() error {
+	switch {
+	case !s.Status.IsValid():
+		return fmt.Errorf("invalid trading status: %q", s.Status)
+	case !s.Reason.IsValid():
+		return fmt.Errorf("invalid reason: %q", s.Reason)
+	}
+	return nil
+}
+
+// OpHaltStatus represents the operational halt status of a security on IEX, from the
+// DEEP Operational Halt Status endpoint or embedded in DEEP.
+// https://iexcloud.io/docs/api/#deep-operational-halt-status
+type OpHaltStatus struct {
+	IsHalted  bool      `json:"isHalted"`
+	Timestamp time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the timestamp field, which is specified in
+// milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (s *OpHaltStatus) UnmarshalJSON(data []byte) (err error) {
+	type opHaltStatus OpHaltStatus
+	type embedded struct {
+		opHaltStatus
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*s = OpHaltStatus(tmp.opHaltStatus)
+		s.Timestamp = fromMillis(tmp.Timestamp)
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (s OpHaltStatus) MarshalJSON() ([]byte, error) {
+	type opHaltStatus OpHaltStatus
+	type embedded struct {
+		opHaltStatus
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.opHaltStatus = opHaltStatus(s)
+	tmp.Timestamp = toMillis(s.Timestamp)
+	return json.Marshal(tmp)
+}
+
+// SSRStatus represents the short sale price test restriction status of a security,
+// from the DEEP Short Sale Price Test Status endpoint or embedded in DEEP.
+// https://iexcloud.io/docs/api/#deep-short-sale-price-test-status
+type SSRStatus struct {
+	IsSSR     bool      `json:"isSSR"`
+	Detail    SSRDetail `json:"detail"`
+	Timestamp time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the timestamp field, which is specified in
+// milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (s *SSRStatus) UnmarshalJSON(data []byte) (err error) {
+	type ssrStatus SSRStatus
+	type embedded struct {
+		ssrStatus
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*s = SSRStatus(tmp.ssrStatus)
+		s.Timestamp = fromMillis(tmp.Timestamp)
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (s SSRStatus) MarshalJSON() ([]byte, error) {
+	type ssrStatus SSRStatus
+	type embedded struct {
+		ssrStatus
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.ssrStatus = ssrStatus(s)
+	tmp.Timestamp = toMillis(s.Timestamp)
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Detail is invalid.
+func (s *SSRStatus) Validate() error {
+	if !s.Detail.IsValid() {
+		return fmt.Errorf("invalid ssr detail: %q", s.Detail)
+	}
+	return nil
+}
+
+// SecurityEvent represents the data returned by the DEEP Security Event endpoint,
+// which marks the open and close of a security on IEX.
+// https://iexcloud.io/docs/api/#deep-security-event
+type SecurityEvent struct {
+	SecurityEvent SecurityEventType `json:"securityEvent"`
+	Timestamp     time.Time         `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the timestamp field, which is specified in
+// milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (e *SecurityEvent) UnmarshalJSON(data []byte) (err error) {
+	type securityEvent SecurityEvent
+	type embedded struct {
+		securityEvent
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*e = SecurityEvent(tmp.securityEvent)
+		e.Timestamp = fromMillis(tmp.Timestamp)
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (e SecurityEvent) MarshalJSON() ([]byte, error) {
+	type securityEvent SecurityEvent
+	type embedded struct {
+		securityEvent
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.securityEvent = securityEvent(e)
+	tmp.Timestamp = toMillis(e.Timestamp)
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the SecurityEvent is invalid.
+func (e *SecurityEvent) Validate() error {
+	if !e.SecurityEvent.IsValid() {
+		return fmt.Errorf("invalid security event: %q", e.SecurityEvent)
+	}
+	return nil
+}
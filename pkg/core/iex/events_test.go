@@ -0,0 +1,108 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package iex_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/iex"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("TradingStatus", func() {
+	var expected map[string]TradingStatus
+	BeforeEach(func() {
+		expected = map[string]TradingStatus{
+			"AAPL": {
+				Status:    TradingStatusTrading,
+				Timestamp: time.Date(2021, time.July, 8, 8, 0, 0, 125e6, time.UTC),
+			},
+			"ZIEXT": {
+				Status:    TradingStatusHalted,
+				Reason:    ReasonHaltNewsPending,
+				Timestamp: time.Date(2021, time.July, 8, 14, 0, 0, 0, time.UTC),
+			},
+		}
+	})
+
+	It("should parse the trading status from the updated field", func() {
+		var res map[string]TradingStatus
+		helper.TestdataFromJSON("core/iex/deep_trading_status.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	Describe("IsHalted()", func() {
+		It("should return false while trading", func() {
+			s := expected["AAPL"]
+			Expect(s.IsHalted()).To(BeFalse())
+		})
+		It("should return true while halted, paused or accepting orders", func() {
+			for _, status := range []TradingStatusCode{TradingStatusHalted, TradingStatusPaused, TradingStatusOrderAcceptance} {
+				s := TradingStatus{Status: status}
+				Expect(s.IsHalted()).To(BeTrue(), string(status))
+			}
+		})
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the TradingStatus is valid", func() {
+			for symbol := range expected {
+				s := expected[symbol]
+				Expect(s.Validate()).To(Succeed())
+			}
+		})
+		It("should return an error if the Reason is invalid", func() {
+			s := expected["ZIEXT"]
+			s.Reason = "T9"
+			Expect(s.Validate()).To(MatchError(`invalid reason: "T9"`))
+		})
+	})
+})
+
+var _ = Describe("SystemEvent", func() {
+	It("should validate the event code and timestamp", func() {
+		e := SystemEvent{SystemEvent: SystemEventEndOfMessages, Timestamp: time.Now()}
+		Expect(e.Validate()).To(Succeed())
+		e.SystemEvent = "X"
+		Expect(e.Validate()).To(MatchError(`invalid system event: "X"`))
+		e.SystemEvent = SystemEventEndOfMessages
+		e.Timestamp = time.Time{}
+		Expect(e.Validate()).To(MatchError("timestamp is missing"))
+	})
+})
+
+var _ = Describe("SSRStatus", func() {
+	It("should validate the detail", func() {
+		s := SSRStatus{IsSSR: true, Detail: SSRDetailActivated}
+		Expect(s.Validate()).To(Succeed())
+		s.Detail = "X"
+		Expect(s.Validate()).To(MatchError(`invalid ssr detail: "X"`))
+	})
+})
+
+var _ = Describe("SecurityEvent", func() {
+	It("should validate the event type", func() {
+		e := SecurityEvent{SecurityEvent: SecurityEventMarketClose}
+		Expect(e.Validate()).To(Succeed())
+		e.SecurityEvent = "MarketPause"
+		Expect(e.Validate()).To(MatchError(`invalid security event: "MarketPause"`))
+	})
+})
@@ -0,0 +1,51 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package iex
+
+import (
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+// GoldenTOPS returns golden data for the TOPS type
+func GoldenTOPS() (t []TOPS) {
+	helper.FromGolden("tops", &t)
+	return
+}
+
+// GoldenLast returns golden data for the Last type
+func GoldenLast() (l []Last) {
+	helper.FromGolden("last", &l)
+	return
+}
+
+// GoldenDEEP returns golden data for the DEEP type
+func GoldenDEEP() (d *DEEP) {
+	helper.FromGolden("deep", &d)
+	return
+}
+
+// GoldenAuction returns golden data for the Auction type, keyed by symbol
+func GoldenAuction() (a map[string]Auction) {
+	helper.FromGolden("deep_auction", &a)
+	return
+}
+
+// GoldenOfficialPrice returns golden data for the OfficialPrice type, keyed by symbol
+func GoldenOfficialPrice() (p map[string]OfficialPrice) {
+	helper.FromGolden("deep_official_price", &p)
+	return
+}
@@ -0,0 +1,84 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package iex
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Last represents a data point from the Last endpoint, which provides the last
+// sale on IEX for a symbol.
+// https://iexcloud.io/docs/api/#last
+type Last struct {
+	Symbol string    `json:"symbol" gorm:"primaryKey;type:character varying"`
+	Price  float64   `json:"price" gorm:"type:double precision"`
+	Size   int64     `json:"size"`
+	Time   time.Time `json:"-" gorm:"primaryKey"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the time field, which is specified in milliseconds
+// since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (l *Last) UnmarshalJSON(data []byte) (err error) {
+	type last Last
+	type embedded struct {
+		last
+		Time int64 `json:"time,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*l = Last(tmp.last)
+		if tmp.Time > 0 {
+			l.Time = time.Unix(tmp.Time/1000, tmp.Time%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (l *Last) MarshalJSON() ([]byte, error) {
+	type last Last
+	type embedded struct {
+		last
+		Time int64 `json:"time"`
+	}
+	tmp := new(embedded)
+	tmp.last = last(*l)
+	if !l.Time.IsZero() {
+		tmp.Time = l.Time.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol is missing or the Price or Size is negative.
+// Symbols that have not traded today have a zero Price, Size and Time.
+func (l *Last) Validate() error {
+	switch {
+	case l.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case l.Price < 0:
+		return fmt.Errorf("price is negative")
+	case l.Size < 0:
+		return fmt.Errorf("size is negative")
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package iex_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/iex"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Last", func() {
+	var expected []Last
+	BeforeEach(func() {
+		expected = []Last{{
+			Symbol: "AAPL",
+			Price:  144.57,
+			Size:   20,
+			Time:   time.Date(2021, time.July, 8, 19, 59, 57, 355e6, time.UTC),
+		}, {
+			Symbol: "ZXZZT",
+		}}
+	})
+
+	It("should parse Last correctly", func() {
+		var res []Last
+		helper.TestdataFromJSON("core/iex/last.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenLast()) {
+			helper.ToGolden("last", expected)
+			Fail(cmp.Diff(expected, GoldenLast()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Last is valid", func() {
+			for idx := range expected {
+				Expect(expected[idx].Validate()).To(Succeed())
+			}
+		})
+		It("should return an error if the Symbol is missing", func() {
+			expected[0].Symbol = ""
+			Expect(expected[0].Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the Price is negative", func() {
+			expected[0].Price = -1
+			Expect(expected[0].Validate()).To(MatchError("price is negative"))
+		})
+		It("should return an error if the Size is negative", func() {
+			expected[0].Size = -1
+			Expect(expected[0].Validate()).To(MatchError("size is negative"))
+		})
+	})
+})
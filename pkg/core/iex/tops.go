@@ -0,0 +1,113 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package iex contains the models for the IEX exchange's own market data, such as
+// the TOPS and Last feeds, as opposed to the consolidated data in package stock.
+// https://iexcloud.io/docs/api/#iex-market-data
+package iex
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TOPS represents a data point from the TOPS endpoint, which provides the IEX
+// top of book quote and last sale for a symbol.
+// https://iexcloud.io/docs/api/#tops
+type TOPS struct {
+	Symbol        string    `json:"symbol" gorm:"primaryKey;type:character varying"`
+	Sector        string    `json:"sector,omitempty" gorm:"type:character varying"`
+	SecurityType  string    `json:"securityType,omitempty" gorm:"type:character varying"`
+	BidPrice      float64   `json:"bidPrice" gorm:"type:double precision"`
+	BidSize       int64     `json:"bidSize"`
+	AskPrice      float64   `json:"askPrice" gorm:"type:double precision"`
+	AskSize       int64     `json:"askSize"`
+	LastUpdated   time.Time `json:"-" gorm:"primaryKey"`
+	LastSalePrice float64   `json:"lastSalePrice" gorm:"type:double precision"`
+	LastSaleSize  int64     `json:"lastSaleSize"`
+	LastSaleTime  time.Time `json:"-"`
+	Volume        int64     `json:"volume"`
+	MarketPercent float64   `json:"marketPercent,omitempty" gorm:"type:double precision"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the lastUpdated and lastSaleTime fields, which are
+// specified in milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (t *TOPS) UnmarshalJSON(data []byte) (err error) {
+	type tops TOPS
+	type embedded struct {
+		tops
+		LastUpdated  int64 `json:"lastUpdated,omitempty"`
+		LastSaleTime int64 `json:"lastSaleTime,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*t = TOPS(tmp.tops)
+		if tmp.LastUpdated > 0 {
+			t.LastUpdated = time.Unix(tmp.LastUpdated/1000, tmp.LastUpdated%1000*1e6) // nolint:gomnd
+		}
+		if tmp.LastSaleTime > 0 {
+			t.LastSaleTime = time.Unix(tmp.LastSaleTime/1000, tmp.LastSaleTime%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (t *TOPS) MarshalJSON() ([]byte, error) {
+	type tops TOPS
+	type embedded struct {
+		tops
+		LastUpdated  int64 `json:"lastUpdated"`
+		LastSaleTime int64 `json:"lastSaleTime"`
+	}
+	tmp := new(embedded)
+	tmp.tops = tops(*t)
+	if !t.LastUpdated.IsZero() {
+		tmp.LastUpdated = t.LastUpdated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !t.LastSaleTime.IsZero() {
+		tmp.LastSaleTime = t.LastSaleTime.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Spread returns the difference between the ask and bid prices, and false if either
+// side of the book is empty.
+func (t *TOPS) Spread() (float64, bool) {
+	if t.BidSize == 0 || t.AskSize == 0 {
+		return 0, false
+	}
+	return t.AskPrice - t.BidPrice, true
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol is missing or a price or size is negative.
+// Symbols that have not quoted or traded today have zero prices and sizes.
+func (t *TOPS) Validate() error {
+	switch {
+	case t.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case t.BidPrice < 0 || t.AskPrice < 0 || t.LastSalePrice < 0:
+		return fmt.Errorf("price is negative")
+	case t.BidSize < 0 || t.AskSize < 0 || t.LastSaleSize < 0:
+		return fmt.Errorf("size is negative")
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package iex_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/iex"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("TOPS", func() {
+	var expected []TOPS
+	BeforeEach(func() {
+		expected = []TOPS{{
+			Symbol:        "AAPL",
+			Sector:        "electronictechnology",
+			SecurityType:  "cs",
+			BidPrice:      144.55,
+			BidSize:       100,
+			AskPrice:      144.58,
+			AskSize:       200,
+			LastUpdated:   time.Date(2021, time.July, 8, 19, 59, 58, 980e6, time.UTC),
+			LastSalePrice: 144.57,
+			LastSaleSize:  20,
+			LastSaleTime:  time.Date(2021, time.July, 8, 19, 59, 57, 355e6, time.UTC),
+			Volume:        2539842,
+			MarketPercent: 0.02406,
+		}, {
+			Symbol:       "ZXZZT",
+			Sector:       "miscellaneous",
+			SecurityType: "cs",
+			LastUpdated:  time.Date(2021, time.July, 8, 20, 0, 0, 490e6, time.UTC),
+		}}
+	})
+
+	It("should parse TOPS correctly", func() {
+		var res []TOPS
+		helper.TestdataFromJSON("core/iex/tops.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenTOPS()) {
+			helper.ToGolden("tops", expected)
+			Fail(cmp.Diff(expected, GoldenTOPS()))
+		}
+	})
+
+	Describe("Spread()", func() {
+		It("should return the spread if both sides are quoted", func() {
+			spread, ok := expected[0].Spread()
+			Expect(ok).To(BeTrue())
+			Expect(spread).To(BeNumerically("~", 0.03, 1e-9))
+		})
+		It("should return false if either side is empty", func() {
+			_, ok := expected[1].Spread()
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the TOPS is valid", func() {
+			for idx := range expected {
+				Expect(expected[idx].Validate()).To(Succeed())
+			}
+		})
+		It("should return an error if the Symbol is missing", func() {
+			expected[0].Symbol = ""
+			Expect(expected[0].Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if a price is negative", func() {
+			expected[0].AskPrice = -1
+			Expect(expected[0].Validate()).To(MatchError("price is negative"))
+		})
+		It("should return an error if a size is negative", func() {
+			expected[0].BidSize = -1
+			Expect(expected[0].Validate()).To(MatchError("size is negative"))
+		})
+	})
+})
@@ -0,0 +1,78 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package market
+
+// These are the time series datasets that hold economic and commodity series.
+const (
+	DatasetTreasury = "treasury"
+	DatasetEconomic = "economic"
+	DatasetEnergy   = "energy"
+)
+
+// EconomicIndicators is the catalog of known economic and commodity series, keyed by Key.
+// Series that IEX adds later can be added to it.
+// https://iexcloud.io/docs/api/#economic-data
+// https://iexcloud.io/docs/api/#commodities
+var EconomicIndicators = indexIndicators([]EconomicIndicator{
+	{"DGS1MO", "1 Month Treasury Constant Maturity Rate", DatasetTreasury, UnitPercent, FrequencyDaily},
+	{"DGS3MO", "3 Month Treasury Constant Maturity Rate", DatasetTreasury, UnitPercent, FrequencyDaily},
+	{"DGS6MO", "6 Month Treasury Constant Maturity Rate", DatasetTreasury, UnitPercent, FrequencyDaily},
+	{"DGS1", "1 Year Treasury Constant Maturity Rate", DatasetTreasury, UnitPercent, FrequencyDaily},
+	{"DGS2", "2 Year Treasury Constant Maturity Rate", DatasetTreasury, UnitPercent, FrequencyDaily},
+	{"DGS3", "3 Year Treasury Constant Maturity Rate", DatasetTreasury, UnitPercent, FrequencyDaily},
+	{"DGS5", "5 Year Treasury Constant Maturity Rate", DatasetTreasury, UnitPercent, FrequencyDaily},
+	{"DGS7", "7 Year Treasury Constant Maturity Rate", DatasetTreasury, UnitPercent, FrequencyDaily},
+	{"DGS10", "10 Year Treasury Constant Maturity Rate", DatasetTreasury, UnitPercent, FrequencyDaily},
+	{"DGS20", "20 Year Treasury Constant Maturity Rate", DatasetTreasury, UnitPercent, FrequencyDaily},
+	{"DGS30", "30 Year Treasury Constant Maturity Rate", DatasetTreasury, UnitPercent, FrequencyDaily},
+
+	{"CPIAUCSL", "Consumer Price Index for All Urban Consumers", DatasetEconomic, UnitIndex, FrequencyMonthly},
+	{"FEDFUNDS", "Effective Federal Funds Rate", DatasetEconomic, UnitPercent, FrequencyMonthly},
+	{"UNRATE", "Unemployment Rate", DatasetEconomic, UnitPercent, FrequencyMonthly},
+	{"PAYEMS", "Total Nonfarm Payrolls", DatasetEconomic, UnitThousandsOfPersons, FrequencyMonthly},
+	{"ICSA", "Initial Claims", DatasetEconomic, UnitPersons, FrequencyWeekly},
+	{"INDPRO", "Industrial Production Index", DatasetEconomic, UnitIndex, FrequencyMonthly},
+	{"HOUST", "Housing Starts", DatasetEconomic, UnitThousandsOfUnits, FrequencyMonthly},
+	{"TOTALSA", "Total Vehicle Sales", DatasetEconomic, UnitMillionsOfUnits, FrequencyMonthly},
+	{"A191RL1Q225SBEA", "Real GDP", DatasetEconomic, UnitPercentChange, FrequencyQuarterly},
+	{"WIMFSL", "Institutional Money Funds", DatasetEconomic, UnitBillionsOfDollars, FrequencyWeekly},
+	{"MORTGAGE30US", "30 Year Fixed Rate Mortgage Average", DatasetEconomic, UnitPercent, FrequencyWeekly},
+	{"MORTGAGE15US", "15 Year Fixed Rate Mortgage Average", DatasetEconomic, UnitPercent, FrequencyWeekly},
+	{"MORTGAGE5US", "5/1 Year Adjustable Rate Mortgage Average", DatasetEconomic, UnitPercent, FrequencyWeekly},
+	{"TERMCBCCALLNS", "Commercial Bank Credit Card Interest Rate", DatasetEconomic, UnitPercent, FrequencyQuarterly},
+	{"RECPROUSM156N", "Smoothed U.S. Recession Probabilities", DatasetEconomic, UnitPercent, FrequencyMonthly},
+
+	{"DCOILWTICO", "Crude Oil Prices: West Texas Intermediate", DatasetEnergy, UnitDollarsPerBarrel, FrequencyDaily},
+	{"DCOILBRENTEU", "Crude Oil Prices: Brent Europe", DatasetEnergy, UnitDollarsPerBarrel, FrequencyDaily},
+	{"DHHNGSP", "Henry Hub Natural Gas Spot Price", DatasetEnergy, UnitDollarsPerMillionBTU, FrequencyDaily},
+	{"DHOILNYH", "No. 2 Heating Oil New York Harbor", DatasetEnergy, UnitDollarsPerGallon, FrequencyDaily},
+	{"DJFUELUSGULF", "Jet Fuel U.S. Gulf Coast", DatasetEnergy, UnitDollarsPerGallon, FrequencyDaily},
+	{"GASDESW", "U.S. Diesel Sales Price", DatasetEnergy, UnitDollarsPerGallon, FrequencyWeekly},
+	{"GASREGCOVW", "U.S. Regular Conventional Gas Price", DatasetEnergy, UnitDollarsPerGallon, FrequencyWeekly},
+	{"GASMIDCOVW", "U.S. Midgrade Conventional Gas Price", DatasetEnergy, UnitDollarsPerGallon, FrequencyWeekly},
+	{"GASPRMCOVW", "U.S. Premium Conventional Gas Price", DatasetEnergy, UnitDollarsPerGallon, FrequencyWeekly},
+	{"DPROPANEMBTX", "Propane Prices Mont Belvieu Texas", DatasetEnergy, UnitDollarsPerGallon, FrequencyDaily},
+})
+
+// indexIndicators returns a map of the indicators keyed by Key.
+func indexIndicators(indicators []EconomicIndicator) map[string]EconomicIndicator {
+	res := make(map[string]EconomicIndicator, len(indicators))
+	for _, indicator := range indicators {
+		res[indicator.Key] = indicator
+	}
+	return res
+}
@@ -0,0 +1,170 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package market
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// EconomicUnit is the unit of measure of an economic series.
+type EconomicUnit string
+
+var (
+	UnitPercent              EconomicUnit = "percent"
+	UnitIndex                EconomicUnit = "index"
+	UnitThousandsOfPersons   EconomicUnit = "thousands of persons"
+	UnitThousandsOfUnits     EconomicUnit = "thousands of units"
+	UnitMillionsOfUnits      EconomicUnit = "millions of units"
+	UnitPersons              EconomicUnit = "persons"
+	UnitDollarsPerBarrel     EconomicUnit = "dollars per barrel"
+	UnitDollarsPerGallon     EconomicUnit = "dollars per gallon"
+	UnitDollarsPerMillionBTU EconomicUnit = "dollars per million btu"
+	UnitBillionsOfDollars    EconomicUnit = "billions of dollars"
+	UnitPercentChange        EconomicUnit = "percent change"
+)
+
+// EconomicFrequency is how often a new value of an economic series is published.
+type EconomicFrequency string
+
+var (
+	FrequencyDaily     EconomicFrequency = "daily"
+	FrequencyWeekly    EconomicFrequency = "weekly"
+	FrequencyMonthly   EconomicFrequency = "monthly"
+	FrequencyQuarterly EconomicFrequency = "quarterly"
+)
+
+// EconomicIndicator describes an economic or commodity series available from IEX.
+// Its Key is both the data point key and the time series key within the Dataset.
+type EconomicIndicator struct {
+	Key       string
+	Name      string
+	Dataset   string
+	Units     EconomicUnit
+	Frequency EconomicFrequency
+}
+
+// EconomicPoint represents a data point from an economic time series dataset, such as
+// https://iexcloud.io/docs/api/#treasury-rates
+type EconomicPoint struct {
+	Key     string    `json:"key" gorm:"primaryKey;type:character varying"`
+	Date    time.Time `json:"-" gorm:"primaryKey;type:date"`
+	Value   float64   `json:"value" gorm:"type:double precision"`
+	ID      string    `json:"id" gorm:"-"`
+	Source  string    `json:"source" gorm:"-"`
+	Subkey  string    `json:"subkey" gorm:"-"`
+	Updated time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date and updated fields, which are specified in
+// milliseconds since the epoch, into time.Times. The date is at midnight UTC.
+// It will return an error if the JSON cannot be unmarshaled.
+func (e *EconomicPoint) UnmarshalJSON(data []byte) (err error) {
+	type economicPoint EconomicPoint
+	type embedded struct {
+		economicPoint
+		Date    int64 `json:"date,omitempty"`
+		Updated int64 `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*e = EconomicPoint(tmp.economicPoint)
+		if tmp.Date > 0 {
+			e.Date = time.Unix(tmp.Date/1000, tmp.Date%1000*1e6).UTC() // nolint:gomnd
+		}
+		if tmp.Updated > 0 {
+			e.Updated = time.Unix(tmp.Updated/1000, tmp.Updated%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (e *EconomicPoint) MarshalJSON() ([]byte, error) {
+	type economicPoint EconomicPoint
+	type embedded struct {
+		economicPoint
+		Date    int64 `json:"date,omitempty"`
+		Updated int64 `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.economicPoint = economicPoint(*e)
+	if !e.Date.IsZero() {
+		tmp.Date = e.Date.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !e.Updated.IsZero() {
+		tmp.Updated = e.Updated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Key or Date is missing.
+func (e *EconomicPoint) Validate() error {
+	switch {
+	case e.Key == "":
+		return fmt.Errorf("key is missing")
+	case e.Date.IsZero():
+		return fmt.Errorf("date is missing")
+	}
+	return nil
+}
+
+// EconomicSeries is the history of an economic indicator, with its Points sorted
+// from the oldest Date.
+type EconomicSeries struct {
+	EconomicIndicator
+	Points []EconomicPoint
+}
+
+// NewEconomicSeries creates an EconomicSeries from points in any order.
+func NewEconomicSeries(indicator EconomicIndicator, points []EconomicPoint) *EconomicSeries {
+	sort.SliceStable(points, func(i, j int) bool { return points[i].Date.Before(points[j].Date) })
+	return &EconomicSeries{EconomicIndicator: indicator, Points: points}
+}
+
+// At returns the latest point dated on or before t, which is the value that was in
+// effect on that date, and false if the series starts after t. This aligns a series
+// published monthly or weekly with daily data such as stock.Historical.
+func (e *EconomicSeries) At(t time.Time) (EconomicPoint, bool) {
+	idx := sort.Search(len(e.Points), func(i int) bool { return e.Points[i].Date.After(t) })
+	if idx == 0 {
+		return EconomicPoint{}, false
+	}
+	return e.Points[idx-1], true
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Key is missing or any of the Points are invalid
+// or belong to a different series.
+func (e *EconomicSeries) Validate() error {
+	if e.Key == "" {
+		return fmt.Errorf("key is missing")
+	}
+	for idx := range e.Points {
+		if err := e.Points[idx].Validate(); err != nil {
+			return err
+		} else if e.Points[idx].Key != e.Key {
+			return fmt.Errorf("point %d is from series %s", idx, e.Points[idx].Key)
+		}
+	}
+	return nil
+}
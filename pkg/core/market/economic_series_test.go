@@ -0,0 +1,125 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package market_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/market"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("EconomicSeries", func() {
+	var expected []EconomicPoint
+	date := func(d int) time.Time { return time.Date(2021, time.July, d, 0, 0, 0, 0, time.UTC) }
+
+	BeforeEach(func() {
+		point := func(d int, value float64, updated time.Time) EconomicPoint {
+			return EconomicPoint{
+				Key:     "DGS10",
+				Date:    date(d),
+				Value:   value,
+				ID:      "TREASURY",
+				Source:  "IEX Cloud",
+				Subkey:  "NONE",
+				Updated: updated,
+			}
+		}
+		expected = []EconomicPoint{
+			point(8, 1.32, time.Date(2021, time.July, 9, 0, 5, 18, 0, time.UTC)),
+			point(7, 1.35, time.Date(2021, time.July, 8, 0, 5, 15, 0, time.UTC)),
+			point(6, 1.37, time.Date(2021, time.July, 7, 0, 5, 11, 0, time.UTC)),
+		}
+	})
+
+	It("should parse EconomicPoints correctly", func() {
+		var res []EconomicPoint
+		helper.TestdataFromJSON("core/market/economic_series.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+		Expect(res[0].Date.Location()).To(Equal(time.UTC))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenEconomicPoint()) {
+			helper.ToGolden("economic_point", expected)
+			Fail(cmp.Diff(expected, GoldenEconomicPoint()))
+		}
+	})
+
+	Describe("Catalog", func() {
+		It("should index every indicator by its key", func() {
+			for key, indicator := range EconomicIndicators {
+				Expect(indicator.Key).To(Equal(key))
+				Expect(indicator.Name).ToNot(BeEmpty(), key)
+				Expect(indicator.Dataset).To(BeElementOf(DatasetTreasury, DatasetEconomic, DatasetEnergy), key)
+				Expect(indicator.Units).ToNot(BeEmpty(), key)
+				Expect(indicator.Frequency).ToNot(BeEmpty(), key)
+			}
+		})
+		It("should include the treasury yields", func() {
+			for _, key := range []string{"DGS1", "DGS2", "DGS5", "DGS10", "DGS30"} {
+				Expect(EconomicIndicators).To(HaveKey(key))
+				Expect(EconomicIndicators[key].Dataset).To(Equal(DatasetTreasury))
+			}
+		})
+	})
+
+	Describe("NewEconomicSeries", func() {
+		var series *EconomicSeries
+		BeforeEach(func() {
+			series = NewEconomicSeries(EconomicIndicators["DGS10"], expected)
+		})
+
+		It("should sort the points from the oldest", func() {
+			Expect(series.Points[0].Date).To(Equal(date(6)))
+			Expect(series.Points[2].Date).To(Equal(date(8)))
+			Expect(series.Units).To(Equal(UnitPercent))
+		})
+		It("should return the point in effect on a date", func() {
+			point, ok := series.At(date(7).Add(16 * time.Hour))
+			Expect(ok).To(BeTrue())
+			Expect(point.Value).To(Equal(1.35))
+			point, ok = series.At(date(12))
+			Expect(ok).To(BeTrue())
+			Expect(point.Value).To(Equal(1.32))
+			_, ok = series.At(date(5))
+			Expect(ok).To(BeFalse())
+		})
+
+		Describe("Validate()", func() {
+			It("should succeed if the series is valid", func() {
+				Expect(series.Validate()).To(Succeed())
+			})
+			It("should return an error if the Key is missing", func() {
+				series.Key = ""
+				Expect(series.Validate()).To(MatchError("key is missing"))
+			})
+			It("should return an error if a point is invalid", func() {
+				series.Points[1].Date = time.Time{}
+				Expect(series.Validate()).To(MatchError("date is missing"))
+			})
+			It("should return an error if a point is from another series", func() {
+				series.Points[2].Key = "DGS30"
+				Expect(series.Validate()).To(MatchError("point 2 is from series DGS30"))
+			})
+		})
+	})
+})
@@ -31,3 +31,33 @@ func GoldenUpcomingEarnings() (e []UpcomingEarning) {
 	helper.FromGolden("upcoming_earnings", &e)
 	return
 }
+
+// GoldenSectorPerformance returns golden data for the SectorPerformance type
+func GoldenSectorPerformance() (s []SectorPerformance) {
+	helper.FromGolden("sector_performance", &s)
+	return
+}
+
+// GoldenVolume returns golden data for the Volume type
+func GoldenVolume() (v []Volume) {
+	helper.FromGolden("volume", &v)
+	return
+}
+
+// GoldenIPOs returns golden data for the IPO type
+func GoldenIPOs() (i IPOs) {
+	helper.FromGolden("ipo", &i)
+	return
+}
+
+// GoldenUpcomingEvents returns golden data for the UpcomingEvents type
+func GoldenUpcomingEvents() (u *UpcomingEvents) {
+	helper.FromGolden("upcoming_events", &u)
+	return
+}
+
+// GoldenEconomicPoint returns golden data for the EconomicPoint type
+func GoldenEconomicPoint() (e []EconomicPoint) {
+	helper.FromGolden("economic_point", &e)
+	return
+}
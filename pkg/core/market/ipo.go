@@ -0,0 +1,152 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package market
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// IPO represents a data point from the IPO calendar endpoints. IEX returns both
+// "rawData" and "viewData" representations of each IPO; this is the former.
+// https://iexcloud.io/docs/api/#ipo-calendar
+type IPO struct {
+	Symbol                 string    `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	CompanyName            string    `json:"companyName,omitempty" gorm:"type:character varying"`
+	ExpectedDate           time.Time `json:"-" gorm:"primaryKey;type:date"`
+	LeadUnderwriters       []string  `json:"leadUnderwriters,omitempty" gorm:"-"`
+	Underwriters           []string  `json:"underwriters,omitempty" gorm:"-"`
+	CompanyCounsel         []string  `json:"companyCounsel,omitempty" gorm:"-"`
+	UnderwriterCounsel     []string  `json:"underwriterCounsel,omitempty" gorm:"-"`
+	Auditor                string    `json:"auditor,omitempty" gorm:"type:character varying"`
+	Market                 string    `json:"market,omitempty" gorm:"type:character varying"`
+	CIK                    string    `json:"cik,omitempty" gorm:"type:character varying"`
+	Address                string    `json:"address,omitempty" gorm:"type:character varying"`
+	City                   string    `json:"city,omitempty" gorm:"type:character varying"`
+	State                  string    `json:"state,omitempty" gorm:"type:character varying"`
+	Zip                    string    `json:"zip,omitempty" gorm:"type:character varying"`
+	Phone                  string    `json:"phone,omitempty" gorm:"type:character varying"`
+	CEO                    string    `json:"ceo,omitempty" gorm:"type:character varying"`
+	Employees              int       `json:"employees,omitempty"`
+	URL                    string    `json:"url,omitempty" gorm:"type:character varying"`
+	Status                 string    `json:"status,omitempty" gorm:"type:character varying"`
+	SharesOffered          int64     `json:"sharesOffered,omitempty"`
+	PriceLow               float64   `json:"priceLow,omitempty" gorm:"type:double precision"`
+	PriceHigh              float64   `json:"priceHigh,omitempty" gorm:"type:double precision"`
+	OfferAmount            *float64  `json:"offerAmount,omitempty" gorm:"type:double precision"`
+	TotalExpenses          float64   `json:"totalExpenses,omitempty" gorm:"type:double precision"`
+	SharesOverAlloted      int64     `json:"sharesOverAlloted,omitempty"`
+	ShareholderShares      *int64    `json:"shareholderShares,omitempty"`
+	SharesOutstanding      int64     `json:"sharesOutstanding,omitempty"`
+	LockupPeriodExpiration time.Time `json:"-" gorm:"type:date"`
+	QuietPeriodExpiration  time.Time `json:"-" gorm:"type:date"`
+	Revenue                float64   `json:"revenue,omitempty" gorm:"type:double precision"`
+	NetIncome              float64   `json:"netIncome,omitempty" gorm:"type:double precision"`
+	TotalAssets            float64   `json:"totalAssets,omitempty" gorm:"type:double precision"`
+	TotalLiabilities       float64   `json:"totalLiabilities,omitempty" gorm:"type:double precision"`
+	StockholderEquity      float64   `json:"stockholderEquity,omitempty" gorm:"type:double precision"`
+	CompanyDescription     string    `json:"companyDescription,omitempty" gorm:"type:text"`
+	BusinessDescription    string    `json:"businessDescription,omitempty" gorm:"type:text"`
+	UseOfProceeds          string    `json:"useOfProceeds,omitempty" gorm:"type:text"`
+	Competition            string    `json:"competition,omitempty" gorm:"type:text"`
+	Amount                 float64   `json:"amount,omitempty" gorm:"type:double precision"`
+	PercentOffered         string    `json:"percentOffered,omitempty" gorm:"type:character varying"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date fields, which are specified as "YYYY-MM-DD",
+// into time.Times by using time.Parse().
+// It will return an error if the JSON cannot be unmarshaled, but NOT if the date parsing fails.
+func (i *IPO) UnmarshalJSON(data []byte) (err error) {
+	type ipo IPO
+	type embedded struct {
+		ipo
+		ExpectedDate           string `json:"expectedDate,omitempty"`
+		LockupPeriodExpiration string `json:"lockupPeriodExpiration,omitempty"`
+		QuietPeriodExpiration  string `json:"quietPeriodExpiration,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*i = IPO(tmp.ipo)
+		// Ignore date parsing issues in case one or more dates are missing
+		i.ExpectedDate, _ = time.Parse("2006-01-02", tmp.ExpectedDate)                     // nolint: errcheck
+		i.LockupPeriodExpiration, _ = time.Parse("2006-01-02", tmp.LockupPeriodExpiration) // nolint: errcheck
+		i.QuietPeriodExpiration, _ = time.Parse("2006-01-02", tmp.QuietPeriodExpiration)   // nolint: errcheck
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (i *IPO) MarshalJSON() ([]byte, error) {
+	type ipo IPO
+	type embedded struct {
+		ipo
+		ExpectedDate           string `json:"expectedDate,omitempty"`
+		LockupPeriodExpiration string `json:"lockupPeriodExpiration,omitempty"`
+		QuietPeriodExpiration  string `json:"quietPeriodExpiration,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.ipo = ipo(*i)
+	if !i.ExpectedDate.IsZero() {
+		tmp.ExpectedDate = i.ExpectedDate.Format("2006-01-02")
+	}
+	if !i.LockupPeriodExpiration.IsZero() {
+		tmp.LockupPeriodExpiration = i.LockupPeriodExpiration.Format("2006-01-02")
+	}
+	if !i.QuietPeriodExpiration.IsZero() {
+		tmp.QuietPeriodExpiration = i.QuietPeriodExpiration.Format("2006-01-02")
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol or ExpectedDate are zero-valued.
+func (i *IPO) Validate() error {
+	switch {
+	case i.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case i.ExpectedDate.IsZero():
+		return fmt.Errorf("expected date is missing")
+	}
+	return nil
+}
+
+// IPOs is a list of IPOs. It unmarshals from either a JSON array of IPOs or the
+// {"rawData": [...], "viewData": [...]} object returned by the IPO calendar endpoints,
+// in which case the rawData is used.
+type IPOs []IPO
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (i *IPOs) UnmarshalJSON(data []byte) error {
+	var res []IPO
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '{' {
+		var calendar struct {
+			RawData []IPO `json:"rawData"`
+		}
+		if err := json.Unmarshal(data, &calendar); err != nil {
+			return err
+		}
+		res = calendar.RawData
+	} else if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	*i = res
+	return nil
+}
@@ -0,0 +1,111 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package market_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/market"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("IPO", func() {
+	var expected IPOs
+	BeforeEach(func() {
+		expected = IPOs{{
+			Symbol:                 "VCNX",
+			CompanyName:            "VACCINEX, INC.",
+			ExpectedDate:           time.Date(2018, time.August, 9, 0, 0, 0, 0, time.UTC),
+			LeadUnderwriters:       []string{"BTIG, LLC", "Oppenheimer & Co. Inc."},
+			Underwriters:           []string{"Ladenburg Thalmann & Co. Inc."},
+			CompanyCounsel:         []string{"Hogan Lovells US LLP and Harter Secrest & Emery LLP"},
+			UnderwriterCounsel:     []string{"Mintz, Levin, Cohn, Ferris, Glovsky and Popeo, P.C."},
+			Auditor:                "Computershare Trust Company, N.A",
+			Market:                 "NASDAQ Global",
+			CIK:                    "0001205922",
+			Address:                "1895 MOUNT HOPE AVE",
+			City:                   "ROCHESTER",
+			State:                  "NY",
+			Zip:                    "14620",
+			Phone:                  "585-271-2700",
+			CEO:                    "Maurice Zauderer",
+			Employees:              44,
+			URL:                    "www.vaccinex.com",
+			Status:                 "Filed",
+			SharesOffered:          3333000,
+			PriceLow:               12,
+			PriceHigh:              15,
+			OfferAmount:            func(f float64) *float64 { return &f }(49995000),
+			TotalExpenses:          2600000,
+			SharesOverAlloted:      499950,
+			SharesOutstanding:      11474715,
+			LockupPeriodExpiration: time.Date(2019, time.February, 5, 0, 0, 0, 0, time.UTC),
+			QuietPeriodExpiration:  time.Date(2018, time.September, 3, 0, 0, 0, 0, time.UTC),
+			Revenue:                206000,
+			NetIncome:              -7862000,
+			TotalAssets:            4946000,
+			TotalLiabilities:       6544000,
+			StockholderEquity:      -133279000,
+			CompanyDescription:     "We are a clinical-stage biotechnology company.",
+			BusinessDescription:    "We are a clinical-stage biotechnology company.",
+			UseOfProceeds:          "We expect to use the net proceeds to fund clinical development.",
+			Competition:            "The biotechnology and pharmaceutical industries are highly competitive.",
+			Amount:                 44995500,
+			PercentOffered:         "29.05",
+		}}
+	})
+
+	It("should parse the IPO calendar correctly", func() {
+		var res IPOs
+		helper.TestdataFromJSON("core/market/ipos.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should parse a list of IPOs correctly", func() {
+		data, err := json.Marshal([]*IPO{&expected[0]})
+		Expect(err).ToNot(HaveOccurred())
+		var res IPOs
+		Expect(json.Unmarshal(data, &res)).To(Succeed())
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenIPOs()) {
+			helper.ToGolden("ipo", expected)
+			Fail(cmp.Diff(expected, GoldenIPOs()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the IPO is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the Symbol is empty", func() {
+			expected[0].Symbol = ""
+			Expect(expected[0].Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the ExpectedDate is zero valued", func() {
+			expected[0].ExpectedDate = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("expected date is missing"))
+		})
+	})
+})
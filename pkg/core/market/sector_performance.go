@@ -0,0 +1,81 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package market
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SectorPerformance represents a data point from the sector performance endpoint.
+// Performance is the percent change of the sector, based on each sector ETF, as a decimal.
+// https://iexcloud.io/docs/api/#sector-performance
+type SectorPerformance struct {
+	Type        string    `json:"type,omitempty" gorm:"type:character varying"`
+	Name        string    `json:"name,omitempty" gorm:"primaryKey;type:character varying"`
+	Performance float64   `json:"performance,omitempty" gorm:"type:double precision"`
+	LastUpdated time.Time `json:"-" gorm:"primaryKey"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the lastUpdated field, which is specified in milliseconds
+// since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (s *SectorPerformance) UnmarshalJSON(data []byte) (err error) {
+	type sectorPerformance SectorPerformance
+	type embedded struct {
+		sectorPerformance
+		LastUpdated int64 `json:"lastUpdated,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*s = SectorPerformance(tmp.sectorPerformance)
+		if tmp.LastUpdated > 0 {
+			s.LastUpdated = time.Unix(tmp.LastUpdated/1000, tmp.LastUpdated%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (s *SectorPerformance) MarshalJSON() ([]byte, error) {
+	type sectorPerformance SectorPerformance
+	type embedded struct {
+		sectorPerformance
+		LastUpdated int64 `json:"lastUpdated,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.sectorPerformance = sectorPerformance(*s)
+	if !s.LastUpdated.IsZero() {
+		tmp.LastUpdated = s.LastUpdated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Name or LastUpdated fields are missing.
+func (s *SectorPerformance) Validate() error {
+	switch {
+	case s.Name == "":
+		return fmt.Errorf("name is missing")
+	case s.LastUpdated.IsZero():
+		return fmt.Errorf("last updated is missing")
+	}
+	return nil
+}
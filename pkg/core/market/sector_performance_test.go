@@ -0,0 +1,75 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package market_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/market"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("SectorPerformance", func() {
+	var expected []SectorPerformance
+	BeforeEach(func() {
+		updated := time.Date(2021, time.July, 8, 20, 0, 0, 490e6, time.UTC)
+		expected = []SectorPerformance{{
+			Type:        "sector",
+			Name:        "Industrials",
+			Performance: 0.00711,
+			LastUpdated: updated,
+		}, {
+			Type:        "sector",
+			Name:        "Technology",
+			Performance: -0.00214,
+			LastUpdated: updated,
+		}}
+	})
+
+	It("should parse sector performance correctly", func() {
+		var res []SectorPerformance
+		helper.TestdataFromJSON("core/market/sector_performance.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenSectorPerformance()) {
+			helper.ToGolden("sector_performance", expected)
+			Fail(cmp.Diff(expected, GoldenSectorPerformance()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the SectorPerformance is valid", func() {
+			for idx := range expected {
+				Expect(expected[idx].Validate()).To(Succeed())
+			}
+		})
+		It("should return an error if the Name is empty", func() {
+			expected[0].Name = ""
+			Expect(expected[0].Validate()).To(MatchError("name is missing"))
+		})
+		It("should return an error if the LastUpdated is zero valued", func() {
+			expected[0].LastUpdated = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("last updated is missing"))
+		})
+	})
+})
@@ -53,6 +53,20 @@ func (u *UpcomingEarning) UnmarshalJSON(data []byte) (err error) {
 	return
 }
 
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (u *UpcomingEarning) MarshalJSON() ([]byte, error) {
+	type earning UpcomingEarning
+	type embedded struct {
+		earning
+		ReportDate string `json:"reportDate"`
+	}
+	tmp := new(embedded)
+	tmp.earning = earning(*u)
+	tmp.ReportDate = u.ReportDate.Format("2006-01-02")
+	return json.Marshal(tmp)
+}
+
 // Validate satisfies the Validator interface.
 // It will return an error if the ReportDate is zero or the Symbol is missing
 func (u *UpcomingEarning) Validate() error {
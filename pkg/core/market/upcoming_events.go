@@ -0,0 +1,62 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package market
+
+import (
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+)
+
+// UpcomingEvents represents the response of the upcoming events endpoint, which
+// includes every category of upcoming event for a symbol or the whole market.
+// https://iexcloud.io/docs/api/#upcoming-events
+type UpcomingEvents struct {
+	IPOs      IPOs               `json:"ipos,omitempty"`
+	Earnings  []UpcomingEarning  `json:"earnings,omitempty"`
+	Dividends []UpcomingDividend `json:"dividends,omitempty"`
+	Splits    []stock.Split      `json:"splits,omitempty"`
+}
+
+// Len returns the total number of upcoming events.
+func (u *UpcomingEvents) Len() int {
+	return len(u.IPOs) + len(u.Earnings) + len(u.Dividends) + len(u.Splits)
+}
+
+// Validate satisfies the Validator interface.
+// It validates each event and returns the first error encountered.
+func (u *UpcomingEvents) Validate() error {
+	for idx := range u.IPOs {
+		if err := u.IPOs[idx].Validate(); err != nil {
+			return err
+		}
+	}
+	for idx := range u.Earnings {
+		if err := u.Earnings[idx].Validate(); err != nil {
+			return err
+		}
+	}
+	for idx := range u.Dividends {
+		if err := u.Dividends[idx].Validate(); err != nil {
+			return err
+		}
+	}
+	for idx := range u.Splits {
+		if err := u.Splits[idx].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
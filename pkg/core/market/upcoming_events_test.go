@@ -0,0 +1,77 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package market_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/market"
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("UpcomingEvents", func() {
+	var expected *UpcomingEvents
+	BeforeEach(func() {
+		expected = &UpcomingEvents{
+			IPOs:      GoldenIPOs(),
+			Earnings:  GoldenUpcomingEarnings()[:2],
+			Dividends: GoldenUpcomingDividends()[:2],
+			Splits: []stock.Split{{
+				ExDate:       time.Date(2019, time.November, 18, 0, 0, 0, 0, time.UTC),
+				DeclaredDate: time.Date(2019, time.October, 13, 0, 0, 0, 0, time.UTC),
+				Ratio:        0.5,
+				ToFactor:     2,
+				FromFactor:   1,
+				Description:  "l-S i-op2r1tf",
+				Symbol:       "MBCN",
+			}},
+		}
+	})
+
+	It("should parse upcoming events correctly", func() {
+		var res *UpcomingEvents
+		helper.TestdataFromJSON("core/market/upcoming_events.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenUpcomingEvents()) {
+			helper.ToGolden("upcoming_events", expected)
+			Fail(cmp.Diff(expected, GoldenUpcomingEvents()))
+		}
+	})
+
+	It("should count every event", func() {
+		Expect(expected.Len()).To(Equal(6))
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if every event is valid", func() {
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should return the first invalid event's error", func() {
+			expected.Dividends[1].Symbol = ""
+			expected.Splits[0].ToFactor = 0
+			Expect(expected.Validate()).To(MatchError("symbol is missing"))
+		})
+	})
+})
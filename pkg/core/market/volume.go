@@ -0,0 +1,87 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package market
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Volume represents a data point from the market volume endpoint, which reports the
+// consolidated volume of each U.S. trading venue for the current trading day.
+// https://iexcloud.io/docs/api/#u-s-exchanges
+type Volume struct {
+	MIC           string    `json:"mic,omitempty" gorm:"primaryKey;type:character varying"`
+	TapeID        string    `json:"tapeId,omitempty" gorm:"type:character varying"`
+	VenueName     string    `json:"venueName,omitempty" gorm:"type:character varying"`
+	Volume        int64     `json:"volume,omitempty"`
+	TapeA         int64     `json:"tapeA,omitempty"`
+	TapeB         int64     `json:"tapeB,omitempty"`
+	TapeC         int64     `json:"tapeC,omitempty"`
+	MarketPercent float64   `json:"marketPercent,omitempty" gorm:"type:double precision"`
+	LastUpdated   time.Time `json:"-" gorm:"primaryKey"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the lastUpdated field, which is specified in milliseconds
+// since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (v *Volume) UnmarshalJSON(data []byte) (err error) {
+	type volume Volume
+	type embedded struct {
+		volume
+		LastUpdated int64 `json:"lastUpdated,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*v = Volume(tmp.volume)
+		if tmp.LastUpdated > 0 {
+			v.LastUpdated = time.Unix(tmp.LastUpdated/1000, tmp.LastUpdated%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (v *Volume) MarshalJSON() ([]byte, error) {
+	type volume Volume
+	type embedded struct {
+		volume
+		LastUpdated int64 `json:"lastUpdated,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.volume = volume(*v)
+	if !v.LastUpdated.IsZero() {
+		tmp.LastUpdated = v.LastUpdated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the MIC or VenueName fields are missing.
+// The LastUpdated field is zero for venues that have not traded yet today.
+func (v *Volume) Validate() error {
+	switch {
+	case v.MIC == "":
+		return fmt.Errorf("mic is missing")
+	case v.VenueName == "":
+		return fmt.Errorf("venue name is missing")
+	}
+	return nil
+}
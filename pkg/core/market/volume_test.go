@@ -0,0 +1,88 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package market_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/market"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Volume", func() {
+	var expected []Volume
+	BeforeEach(func() {
+		expected = []Volume{{
+			MIC:           "TRF",
+			TapeID:        "-",
+			VenueName:     "TRF Volume",
+			Volume:        589171705,
+			TapeA:         305187928,
+			TapeB:         119650027,
+			TapeC:         164333750,
+			MarketPercent: 0.37027,
+			LastUpdated:   time.Date(2021, time.July, 8, 20, 0, 0, 490e6, time.UTC),
+		}, {
+			MIC:           "XNGS",
+			TapeID:        "Q",
+			VenueName:     "NASDAQ",
+			Volume:        213908393,
+			TapeA:         90791123,
+			TapeB:         34019751,
+			TapeC:         89097519,
+			MarketPercent: 0.13443,
+			LastUpdated:   time.Date(2021, time.July, 8, 19, 59, 58, 980e6, time.UTC),
+		}}
+	})
+
+	It("should parse market volume correctly", func() {
+		var res []Volume
+		helper.TestdataFromJSON("core/market/volume.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenVolume()) {
+			helper.ToGolden("volume", expected)
+			Fail(cmp.Diff(expected, GoldenVolume()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Volume is valid", func() {
+			for idx := range expected {
+				Expect(expected[idx].Validate()).To(Succeed())
+			}
+		})
+		It("should succeed if the LastUpdated is zero valued", func() {
+			expected[0].LastUpdated = time.Time{}
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the MIC is empty", func() {
+			expected[0].MIC = ""
+			Expect(expected[0].Validate()).To(MatchError("mic is missing"))
+		})
+		It("should return an error if the VenueName is empty", func() {
+			expected[0].VenueName = ""
+			Expect(expected[0].Validate()).To(MatchError("venue name is missing"))
+		})
+	})
+})
@@ -0,0 +1,145 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package reference
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Exchange represents one datum of that returned by the ref-data/exchanges endpoint.
+// https://iexcloud.io/docs/api/#international-exchanges
+type Exchange struct {
+	Exchange    string `json:"exchange" gorm:"primaryKey;type:character varying"`
+	Region      string `json:"region" gorm:"type:character(2)"`
+	Description string `json:"description" gorm:"type:character varying"`
+	MIC         string `json:"mic" gorm:"type:character(4)"`
+	// Suffix is appended to the local symbol to form the IEX symbol, e.g. "-LN" in "VOD-LN".
+	// It is empty for U.S. exchanges.
+	Suffix      string `json:"exchangeSuffix" gorm:"type:character varying"`
+	Name        string `json:"exchangeName" gorm:"type:character varying"`
+	Segment     string `json:"exchangeSegment" gorm:"type:character varying"`
+	SegmentName string `json:"exchangeSegmentName" gorm:"type:character varying"`
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Exchange, Region, or MIC fields are equal to their zero value.
+func (e *Exchange) Validate() error {
+	switch {
+	case e.Exchange == "":
+		return fmt.Errorf("missing exchange")
+	case e.Region == "":
+		return fmt.Errorf("missing region")
+	case e.MIC == "":
+		return fmt.Errorf("missing MIC")
+	}
+	return nil
+}
+
+// ExchangeSuffixes maps the suffixes of international symbols to the MIC of their exchange.
+// It covers the major markets, and can be extended or replaced with an ExchangeDirectory
+// built from Reference.Exchanges.
+var ExchangeSuffixes = map[string]string{
+	"AU": "XASX", // ASX
+	"AV": "XWBO", // Wiener Börse
+	"BB": "XBRU", // Euronext Brussels
+	"CN": "XCNQ", // Canadian Securities Exchange
+	"CT": "XTSE", // Toronto Stock Exchange
+	"CV": "XTSX", // TSX Venture Exchange
+	"DC": "XCSE", // Nasdaq Copenhagen
+	"FH": "XHEL", // Nasdaq Helsinki
+	"FP": "XPAR", // Euronext Paris
+	"GY": "XETR", // Xetra
+	"HK": "XHKG", // Hong Kong Exchanges
+	"ID": "XDUB", // Euronext Dublin
+	"IM": "XMIL", // Borsa Italiana
+	"JP": "XTKS", // Tokyo Stock Exchange
+	"LN": "XLON", // London Stock Exchange
+	"MM": "XMEX", // Bolsa Mexicana de Valores
+	"NA": "XAMS", // Euronext Amsterdam
+	"NO": "XOSL", // Oslo Børs
+	"PL": "XLIS", // Euronext Lisbon
+	"SE": "XSWX", // SIX Swiss Exchange
+	"SM": "XMAD", // Bolsa de Madrid
+	"SP": "XSES", // Singapore Exchange
+	"SS": "XSTO", // Nasdaq Stockholm
+}
+
+// normalizeSuffix upper cases a suffix and removes its leading dash, since IEX writes
+// suffixes both ways.
+func normalizeSuffix(suffix string) string {
+	return strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(suffix)), "-")
+}
+
+// SplitSymbol splits an international symbol, such as "VOD-LN", into its local symbol and
+// exchange suffix, without the dash. Only the suffixes in ExchangeSuffixes are split off,
+// so U.S. symbols that contain a dash are returned whole, with an empty suffix.
+func SplitSymbol(symbol string) (local, suffix string) {
+	idx := strings.LastIndexByte(symbol, '-')
+	if idx <= 0 {
+		return symbol, ""
+	}
+	if _, ok := ExchangeSuffixes[normalizeSuffix(symbol[idx+1:])]; !ok {
+		return symbol, ""
+	}
+	return symbol[:idx], normalizeSuffix(symbol[idx+1:])
+}
+
+// ExchangeDirectory looks up exchanges by suffix, MIC, or the suffix of a symbol.
+type ExchangeDirectory struct {
+	bySuffix map[string]Exchange
+	byMIC    map[string]Exchange
+}
+
+// NewExchangeDirectory creates an ExchangeDirectory from the result of Reference.Exchanges.
+// When several exchanges share a suffix or MIC, the first one is used.
+func NewExchangeDirectory(exchanges []Exchange) *ExchangeDirectory {
+	d := &ExchangeDirectory{bySuffix: make(map[string]Exchange), byMIC: make(map[string]Exchange)}
+	for _, e := range exchanges {
+		if suffix := normalizeSuffix(e.Suffix); suffix != "" {
+			if _, ok := d.bySuffix[suffix]; !ok {
+				d.bySuffix[suffix] = e
+			}
+		}
+		if _, ok := d.byMIC[e.MIC]; !ok && e.MIC != "" {
+			d.byMIC[e.MIC] = e
+		}
+	}
+	return d
+}
+
+// BySuffix returns the exchange with the given suffix, with or without its dash.
+func (d *ExchangeDirectory) BySuffix(suffix string) (Exchange, bool) {
+	e, ok := d.bySuffix[normalizeSuffix(suffix)]
+	return e, ok
+}
+
+// ByMIC returns the exchange with the given market identifier code.
+func (d *ExchangeDirectory) ByMIC(mic string) (Exchange, bool) {
+	e, ok := d.byMIC[strings.ToUpper(mic)]
+	return e, ok
+}
+
+// ForSymbol returns the exchange of an international symbol, such as "VOD-LN", and false
+// for symbols without a suffix in the directory, such as U.S. symbols.
+func (d *ExchangeDirectory) ForSymbol(symbol string) (Exchange, bool) {
+	idx := strings.LastIndexByte(symbol, '-')
+	if idx <= 0 {
+		return Exchange{}, false
+	}
+	return d.BySuffix(symbol[idx+1:])
+}
@@ -0,0 +1,142 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package reference_test
+
+import (
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/reference"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Exchange", func() {
+	var expected []Exchange
+
+	BeforeEach(func() {
+		expected = []Exchange{{
+			Exchange:    "LON",
+			Region:      "GB",
+			Description: "London Stock Exchange",
+			MIC:         "XLON",
+			Suffix:      "-LN",
+			Name:        "London Stock Exchange",
+			Segment:     "XLON",
+			SegmentName: "London Stock Exchange",
+		}, {
+			Exchange:    "TSE",
+			Region:      "CA",
+			Description: "Toronto Stock Exchange",
+			MIC:         "XTSE",
+			Suffix:      "-CT",
+			Name:        "Toronto Stock Exchange",
+			Segment:     "XTSE",
+			SegmentName: "Toronto Stock Exchange",
+		}, {
+			Exchange:    "NYS",
+			Region:      "US",
+			Description: "New York Stock Exchange",
+			MIC:         "XNYS",
+			Name:        "New York Stock Exchange",
+			Segment:     "XNYS",
+			SegmentName: "New York Stock Exchange",
+		}}
+	})
+
+	It("should parse exchanges correctly", func() {
+		var res []Exchange
+		helper.TestdataFromJSON("core/reference/exchanges.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenExchange()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("exchange", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Exchange is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the Exchange is empty", func() {
+			expected[0].Exchange = ""
+			Expect(expected[0].Validate()).To(MatchError("missing exchange"))
+		})
+		It("should return an error if the Region is empty", func() {
+			expected[0].Region = ""
+			Expect(expected[0].Validate()).To(MatchError("missing region"))
+		})
+		It("should return an error if the MIC is empty", func() {
+			expected[0].MIC = ""
+			Expect(expected[0].Validate()).To(MatchError("missing MIC"))
+		})
+	})
+
+	Describe("SplitSymbol", func() {
+		It("should split off known suffixes", func() {
+			local, suffix := SplitSymbol("VOD-LN")
+			Expect(local).To(Equal("VOD"))
+			Expect(suffix).To(Equal("LN"))
+			local, suffix = SplitSymbol("RY-CT")
+			Expect(local).To(Equal("RY"))
+			Expect(ExchangeSuffixes[suffix]).To(Equal("XTSE"))
+		})
+		It("should not split U.S. symbols", func() {
+			for _, symbol := range []string{"AAPL", "BRK.B", "PSA-H", "-LN"} {
+				local, suffix := SplitSymbol(symbol)
+				Expect(local).To(Equal(symbol))
+				Expect(suffix).To(BeEmpty())
+			}
+		})
+	})
+
+	Describe("ExchangeDirectory", func() {
+		var d *ExchangeDirectory
+		BeforeEach(func() {
+			d = NewExchangeDirectory(expected)
+		})
+
+		It("should look up exchanges by suffix with or without a dash", func() {
+			e, ok := d.BySuffix("-LN")
+			Expect(ok).To(BeTrue())
+			Expect(e.MIC).To(Equal("XLON"))
+			e, ok = d.BySuffix("ct")
+			Expect(ok).To(BeTrue())
+			Expect(e.MIC).To(Equal("XTSE"))
+			_, ok = d.BySuffix("")
+			Expect(ok).To(BeFalse())
+		})
+		It("should look up exchanges by MIC", func() {
+			e, ok := d.ByMIC("xnys")
+			Expect(ok).To(BeTrue())
+			Expect(e.Exchange).To(Equal("NYS"))
+		})
+		It("should look up the exchange of a symbol", func() {
+			e, ok := d.ForSymbol("VOD-LN")
+			Expect(ok).To(BeTrue())
+			Expect(e.Exchange).To(Equal("LON"))
+			_, ok = d.ForSymbol("AAPL")
+			Expect(ok).To(BeFalse())
+			_, ok = d.ForSymbol("SAP-GY")
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
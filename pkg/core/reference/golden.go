@@ -29,3 +29,21 @@ func GoldenOptionSymbol() (symbols OptionSymbol) {
 	helper.FromGolden("option_symbol", &symbols)
 	return
 }
+
+// GoldenTradingDate returns golden data for the TradingDate type
+func GoldenTradingDate() (dates []TradingDate) {
+	helper.FromGolden("trading_date", &dates)
+	return
+}
+
+// GoldenExchange returns golden data for the Exchange type
+func GoldenExchange() (exchanges []Exchange) {
+	helper.FromGolden("exchange", &exchanges)
+	return
+}
+
+// GoldenRegionSymbol returns golden data for the Symbol type from an international region
+func GoldenRegionSymbol() (symbols []Symbol) {
+	helper.FromGolden("region_symbol", &symbols)
+	return
+}
@@ -37,6 +37,9 @@ type Symbol struct { // nolint:govet
 	IEXID     string    `json:"iexId" gorm:"type:character(20);check:iex_id IS NOT NULL OR figi IS NOT NULL OR cik IS NOT NULL;uniqueIndex:,where:iex_id IS NOT NULL"` // nolint:lll
 	FIGI      string    `json:"figi" gorm:"type:character(12);uniqueIndex:,where:figi IS NOT NULL"`
 	CIK       string    `json:"cik" gorm:"type:character(10);uniqueIndex:,where:cik IS NOT NULL"`
+	// ExchangeSuffix and ExchangeName are only returned for international symbols.
+	ExchangeSuffix string `json:"exchangeSuffix,omitempty" gorm:"type:character varying"`
+	ExchangeName   string `json:"exchangeName,omitempty" gorm:"type:character varying"`
 }
 
 // UnmarshalJSON satisfies the json.Unmarshaler interface.
@@ -72,12 +75,13 @@ func (s *Symbol) MarshalJSON() ([]byte, error) {
 }
 
 // Validate satisfies the Validator interface.
-// It will return an error if the Symbol, IEXID, or Date fields are equal to their zero value.
+// It will return an error if the Symbol or Date fields are equal to their zero value, or the
+// CIK is missing from a U.S. symbol. International symbols are not registered with the SEC.
 func (s *Symbol) Validate() error {
 	switch {
 	case s.Symbol == "":
 		return fmt.Errorf("missing symbol")
-	case s.CIK == "":
+	case s.CIK == "" && (s.Region == "" || s.Region == "US"):
 		return fmt.Errorf("missing CIK")
 	case s.Date.IsZero():
 		return fmt.Errorf("missing date")
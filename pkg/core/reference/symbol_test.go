@@ -71,6 +71,17 @@ var _ = Describe("Symbol", func() {
 		}
 	})
 
+	It("should parse international symbols correctly", func() {
+		var symbols []Symbol
+		helper.TestdataFromJSON("core/reference/region_symbols.json", &symbols)
+		Expect(symbols).To(HaveLen(2))
+		Expect(symbols[0].Symbol).To(Equal("VOD-LN"))
+		Expect(symbols[0].ExchangeSuffix).To(Equal("LN"))
+		Expect(symbols[0].ExchangeName).To(Equal("London Stock Exchange"))
+		Expect(symbols[0].CIK).To(BeEmpty())
+		Expect(cmp.Equal(symbols, GoldenRegionSymbol())).To(BeTrue(), cmp.Diff(symbols, GoldenRegionSymbol()))
+	})
+
 	Describe("Validate()", func() {
 		var s Symbol
 		BeforeEach(func() {
@@ -88,6 +99,12 @@ var _ = Describe("Symbol", func() {
 			s.CIK = ""
 			Expect(s.Validate()).To(MatchError("missing CIK"))
 		})
+		It("should not require a CIK for international symbols", func() {
+			s = GoldenRegionSymbol()[0]
+			Expect(s.Validate()).To(Succeed())
+			s.Region = "US"
+			Expect(s.Validate()).To(MatchError("missing CIK"))
+		})
 		It("should return an error if the Date is zero valued", func() {
 			s.Date = time.Time{}
 			Expect(s.Validate()).To(MatchError("missing date"))
@@ -0,0 +1,77 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package reference
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TradingDate represents one datum of that returned by the ref-data/us/dates endpoint.
+// The endpoint returns trading days or holidays, each with the date trades made that
+// day settle on.
+// https://iexcloud.io/docs/api/#u-s-holidays-and-trading-dates
+type TradingDate struct {
+	Date           time.Time `json:"-" gorm:"primaryKey;type:date"`
+	SettlementDate time.Time `json:"-" gorm:"type:date"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date fields, which are specified as "YYYY-MM-DD",
+// into time.Times by using time.Parse().
+// It will return an error if the JSON cannot be unmarshaled or the date cannot be parsed,
+// but NOT if the settlement date parsing fails.
+func (t *TradingDate) UnmarshalJSON(data []byte) (err error) {
+	type embedded struct {
+		Date           string `json:"date"`
+		SettlementDate string `json:"settlementDate"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		t.Date, err = time.Parse("2006-01-02", tmp.Date)
+		t.SettlementDate, _ = time.Parse("2006-01-02", tmp.SettlementDate) // nolint:errcheck
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (t *TradingDate) MarshalJSON() ([]byte, error) {
+	type embedded struct {
+		Date           string `json:"date"`
+		SettlementDate string `json:"settlementDate,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.Date = t.Date.Format("2006-01-02")
+	if !t.SettlementDate.IsZero() {
+		tmp.SettlementDate = t.SettlementDate.Format("2006-01-02")
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Date is missing or the SettlementDate, if present, is before it.
+func (t *TradingDate) Validate() error {
+	switch {
+	case t.Date.IsZero():
+		return fmt.Errorf("missing date")
+	case !t.SettlementDate.IsZero() && t.SettlementDate.Before(t.Date):
+		return fmt.Errorf("settlement date is before date")
+	}
+	return nil
+}
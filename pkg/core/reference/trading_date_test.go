@@ -0,0 +1,73 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package reference_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/reference"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("TradingDate", func() {
+	var expected []TradingDate
+
+	BeforeEach(func() {
+		date := func(d int) time.Time { return time.Date(2021, time.July, d, 0, 0, 0, 0, time.UTC) }
+		expected = []TradingDate{
+			{Date: date(2), SettlementDate: date(7)},
+			{Date: date(6), SettlementDate: date(8)},
+			{Date: date(7), SettlementDate: date(9)},
+		}
+	})
+
+	It("should parse trading dates correctly", func() {
+		var dates []TradingDate
+		helper.TestdataFromJSON("core/reference/trading_dates.json", &dates)
+		Expect(cmp.Equal(expected, dates)).To(BeTrue(), cmp.Diff(expected, dates))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenTradingDate()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("trading_date", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the TradingDate is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the Date is zero valued", func() {
+			expected[0].Date = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("missing date"))
+		})
+		It("should succeed if the SettlementDate is missing", func() {
+			expected[0].SettlementDate = time.Time{}
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the SettlementDate is before the Date", func() {
+			expected[0].SettlementDate = expected[0].Date.AddDate(0, 0, -1)
+			Expect(expected[0].Validate()).To(MatchError("settlement date is before date"))
+		})
+	})
+})
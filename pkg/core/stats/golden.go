@@ -0,0 +1,45 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stats
+
+import (
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+// GoldenIntraday returns golden data for the Intraday type
+func GoldenIntraday() (i *Intraday) {
+	helper.FromGolden("intraday", &i)
+	return
+}
+
+// GoldenRecent returns golden data for the Recent type
+func GoldenRecent() (r []Recent) {
+	helper.FromGolden("recent", &r)
+	return
+}
+
+// GoldenRecords returns golden data for the Records type
+func GoldenRecords() (r *Records) {
+	helper.FromGolden("records", &r)
+	return
+}
+
+// GoldenHistoricalSummary returns golden data for the HistoricalSummary type
+func GoldenHistoricalSummary() (h []HistoricalSummary) {
+	helper.FromGolden("historical_summary", &h)
+	return
+}
@@ -0,0 +1,162 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// HistoricalSummary represents one month from the historical summary stats endpoint.
+// https://iexcloud.io/docs/api/#historical-summary
+type HistoricalSummary struct {
+	Date                     time.Time `json:"-" gorm:"primaryKey"`
+	AverageDailyVolume       float64   `json:"averageDailyVolume"`
+	AverageDailyRoutedVolume float64   `json:"averageDailyRoutedVolume"`
+	AverageMarketShare       float64   `json:"averageMarketShare"`
+	AverageOrderSize         float64   `json:"averageOrderSize"`
+	AverageFillSize          float64   `json:"averageFillSize"`
+	Bin100Percent            float64   `json:"bin100Percent"`
+	Bin101Percent            float64   `json:"bin101Percent"`
+	Bin200Percent            float64   `json:"bin200Percent"`
+	Bin300Percent            float64   `json:"bin300Percent"`
+	Bin400Percent            float64   `json:"bin400Percent"`
+	Bin500Percent            float64   `json:"bin500Percent"`
+	Bin1000Percent           float64   `json:"bin1000Percent"`
+	Bin5000Percent           float64   `json:"bin5000Percent"`
+	Bin10000Percent          float64   `json:"bin10000Percent"`
+	Bin10000Trades           int64     `json:"bin10000Trades"`
+	Bin20000Trades           int64     `json:"bin20000Trades"`
+	Bin50000Trades           int64     `json:"bin50000Trades"`
+	UniqueSymbolsTraded      int64     `json:"uniqueSymbolsTraded"`
+	BlockPercent             float64   `json:"blockPercent"`
+	SelfCrossPercent         float64   `json:"selfCrossPercent"`
+	ETFPercent               float64   `json:"etfPercent"`
+	LargeCapPercent          float64   `json:"largeCapPercent"`
+	MidCapPercent            float64   `json:"midCapPercent"`
+	SmallCapPercent          float64   `json:"smallCapPercent"`
+	// Venues holds the routing statistics for each venue IEX routed to, keyed by the
+	// venue code, e.g. "ARCX". IEX reports these as venue<code>FirstWave<stat> fields.
+	Venues map[string]*VenueRouting `json:"-" gorm:"-"`
+}
+
+// VenueRouting holds the first wave routing statistics for one venue.
+type VenueRouting struct {
+	FirstWaveWeight float64 `json:"firstWaveWeight"`
+	FirstWaveRate   float64 `json:"firstWaveRate"`
+}
+
+// historicalSummaryDateFormats are the formats IEX uses for the date field.
+var historicalSummaryDateFormats = []string{"2006-01-02", "200601", "2006-01"}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date field into a time.Time and collects
+// the venue routing fields into Venues.
+// It will return an error if the JSON cannot be unmarshaled.
+func (h *HistoricalSummary) UnmarshalJSON(data []byte) (err error) {
+	type historicalSummary HistoricalSummary
+	type embedded struct {
+		historicalSummary
+		Date string `json:"date"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err != nil {
+		return
+	}
+	*h = HistoricalSummary(tmp.historicalSummary)
+	for _, layout := range historicalSummaryDateFormats {
+		if date, e := time.Parse(layout, tmp.Date); e == nil {
+			h.Date = date
+			break
+		}
+	}
+
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return
+	}
+	for key, val := range fields {
+		if !strings.HasPrefix(key, "venue") {
+			continue
+		}
+		var f float64
+		if json.Unmarshal(val, &f) != nil {
+			continue
+		}
+		switch venue := strings.TrimPrefix(key, "venue"); {
+		case strings.HasSuffix(venue, "FirstWaveWeight"):
+			h.venue(strings.TrimSuffix(venue, "FirstWaveWeight")).FirstWaveWeight = f
+		case strings.HasSuffix(venue, "FirstWaveRate"):
+			h.venue(strings.TrimSuffix(venue, "FirstWaveRate")).FirstWaveRate = f
+		}
+	}
+	return
+}
+
+// venue returns the routing statistics for the named venue, creating them if necessary.
+func (h *HistoricalSummary) venue(name string) *VenueRouting {
+	if h.Venues == nil {
+		h.Venues = make(map[string]*VenueRouting)
+	}
+	if h.Venues[name] == nil {
+		h.Venues[name] = new(VenueRouting)
+	}
+	return h.Venues[name]
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does, writing the date as YYYY-MM-DD.
+func (h *HistoricalSummary) MarshalJSON() ([]byte, error) {
+	type historicalSummary HistoricalSummary
+	type embedded struct {
+		historicalSummary
+		Date string `json:"date,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.historicalSummary = historicalSummary(*h)
+	if !h.Date.IsZero() {
+		tmp.Date = h.Date.Format("2006-01-02")
+	}
+	data, err := json.Marshal(tmp)
+	if err != nil || len(h.Venues) == 0 {
+		return data, err
+	}
+
+	fields := make(map[string]interface{})
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name, v := range h.Venues {
+		fields["venue"+name+"FirstWaveWeight"] = v.FirstWaveWeight
+		fields["venue"+name+"FirstWaveRate"] = v.FirstWaveRate
+	}
+	return json.Marshal(fields)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Date is missing or the AverageDailyVolume is zero.
+func (h *HistoricalSummary) Validate() error {
+	switch {
+	case h.Date.IsZero():
+		return fmt.Errorf("date is missing")
+	case h.AverageDailyVolume == 0:
+		return fmt.Errorf("average daily volume is zero")
+	}
+	return nil
+}
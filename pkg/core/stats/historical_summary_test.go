@@ -0,0 +1,107 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stats_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stats"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("HistoricalSummary", func() {
+	var expected []HistoricalSummary
+	BeforeEach(func() {
+		expected = []HistoricalSummary{{
+			Date:                     time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC),
+			AverageDailyVolume:       271044312.36,
+			AverageDailyRoutedVolume: 37218523.09,
+			AverageMarketShare:       0.02541,
+			AverageOrderSize:         412,
+			AverageFillSize:          161,
+			Bin100Percent:            0.53103,
+			Bin101Percent:            0.46897,
+			Bin200Percent:            0.61271,
+			Bin300Percent:            0.0512,
+			Bin400Percent:            0.02017,
+			Bin500Percent:            0.02885,
+			Bin1000Percent:           0.01392,
+			Bin5000Percent:           0.0015,
+			Bin10000Percent:          0.00062,
+			Bin10000Trades:           4135,
+			Bin20000Trades:           1422,
+			Bin50000Trades:           198,
+			UniqueSymbolsTraded:      10498,
+			BlockPercent:             0.04307,
+			SelfCrossPercent:         0.01961,
+			ETFPercent:               0.21458,
+			LargeCapPercent:          0.38167,
+			MidCapPercent:            0.21901,
+			SmallCapPercent:          0.18474,
+			Venues: map[string]*VenueRouting{
+				"ARCX": {FirstWaveWeight: 0.22063, FirstWaveRate: 0.97145},
+				"XNGS": {FirstWaveWeight: 0.31017, FirstWaveRate: 0.98421},
+			},
+		}}
+	})
+
+	It("should parse the historical summary correctly", func() {
+		var res []HistoricalSummary
+		helper.TestdataFromJSON("core/stats/historical_summary.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenHistoricalSummary()) {
+			helper.ToGolden("historical_summary", expected)
+			Fail(cmp.Diff(expected, GoldenHistoricalSummary()))
+		}
+	})
+
+	It("should parse month dates", func() {
+		var res HistoricalSummary
+		Expect(json.Unmarshal([]byte(`{"date":"202106"}`), &res)).To(Succeed())
+		Expect(res.Date).To(Equal(expected[0].Date))
+	})
+
+	It("should marshal the venues back to venue fields", func() {
+		data, err := json.Marshal(&expected[0])
+		Expect(err).ToNot(HaveOccurred())
+		var res HistoricalSummary
+		Expect(json.Unmarshal(data, &res)).To(Succeed())
+		Expect(cmp.Equal(expected[0], res)).To(BeTrue(), cmp.Diff(expected[0], res))
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the HistoricalSummary is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the Date is missing", func() {
+			expected[0].Date = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("date is missing"))
+		})
+		It("should return an error if the AverageDailyVolume is zero", func() {
+			expected[0].AverageDailyVolume = 0
+			Expect(expected[0].Validate()).To(MatchError("average daily volume is zero"))
+		})
+	})
+})
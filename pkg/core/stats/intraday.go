@@ -0,0 +1,88 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package stats contains the models for the IEX exchange statistics endpoints,
+// which report the volume, market share and routing performance of the IEX exchange itself.
+// https://iexcloud.io/docs/api/#stats
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Intraday represents the data returned by the intraday stats endpoint.
+// Each statistic carries its own update time.
+// https://iexcloud.io/docs/api/#intraday
+type Intraday struct {
+	Volume        Stat `json:"volume"`
+	SymbolsTraded Stat `json:"symbolsTraded"`
+	RoutedVolume  Stat `json:"routedVolume"`
+	Notional      Stat `json:"notional"`
+	MarketShare   Stat `json:"marketShare"`
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Volume has never been updated.
+func (i *Intraday) Validate() error {
+	if i.Volume.LastUpdated.IsZero() {
+		return fmt.Errorf("volume is missing")
+	}
+	return nil
+}
+
+// Stat is a single intraday statistic and the time it was last updated.
+type Stat struct {
+	Value       float64   `json:"value"`
+	LastUpdated time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the lastUpdated field, which is specified in milliseconds
+// since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (s *Stat) UnmarshalJSON(data []byte) (err error) {
+	type stat Stat
+	type embedded struct {
+		stat
+		LastUpdated int64 `json:"lastUpdated,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*s = Stat(tmp.stat)
+		if tmp.LastUpdated > 0 {
+			s.LastUpdated = time.Unix(tmp.LastUpdated/1000, tmp.LastUpdated%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (s *Stat) MarshalJSON() ([]byte, error) {
+	type stat Stat
+	type embedded struct {
+		stat
+		LastUpdated int64 `json:"lastUpdated,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.stat = stat(*s)
+	if !s.LastUpdated.IsZero() {
+		tmp.LastUpdated = s.LastUpdated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
@@ -0,0 +1,66 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stats_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stats"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Intraday", func() {
+	var expected *Intraday
+	BeforeEach(func() {
+		updated := time.Date(2021, time.July, 8, 20, 0, 0, 490e6, time.UTC)
+		routed := time.Date(2021, time.July, 8, 19, 59, 58, 980e6, time.UTC)
+		expected = &Intraday{
+			Volume:        Stat{Value: 26908038, LastUpdated: updated},
+			SymbolsTraded: Stat{Value: 4089, LastUpdated: updated},
+			RoutedVolume:  Stat{Value: 4546132, LastUpdated: routed},
+			Notional:      Stat{Value: 1254723618.46, LastUpdated: updated},
+			MarketShare:   Stat{Value: 0.02484, LastUpdated: routed},
+		}
+	})
+
+	It("should parse intraday stats correctly", func() {
+		var res *Intraday
+		helper.TestdataFromJSON("core/stats/intraday.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenIntraday()) {
+			helper.ToGolden("intraday", expected)
+			Fail(cmp.Diff(expected, GoldenIntraday()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Intraday is valid", func() {
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should return an error if the Volume was never updated", func() {
+			expected.Volume.LastUpdated = time.Time{}
+			Expect(expected.Validate()).To(MatchError("volume is missing"))
+		})
+	})
+})
@@ -0,0 +1,81 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Recent represents one trading day from the recent stats endpoint, which covers
+// the last five trading days.
+// https://iexcloud.io/docs/api/#recent
+type Recent struct {
+	Date         time.Time `json:"-" gorm:"primaryKey"`
+	Volume       int64     `json:"volume"`
+	RoutedVolume int64     `json:"routedVolume"`
+	MarketShare  float64   `json:"marketShare" gorm:"type:double precision"`
+	IsHalfday    bool      `json:"isHalfday"`
+	LitVolume    int64     `json:"litVolume"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date field, which is specified as YYYY-MM-DD,
+// into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (r *Recent) UnmarshalJSON(data []byte) (err error) {
+	type recent Recent
+	type embedded struct {
+		recent
+		Date string `json:"date"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*r = Recent(tmp.recent)
+		r.Date, _ = time.Parse("2006-01-02", tmp.Date) // nolint:errcheck
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (r *Recent) MarshalJSON() ([]byte, error) {
+	type recent Recent
+	type embedded struct {
+		recent
+		Date string `json:"date,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.recent = recent(*r)
+	if !r.Date.IsZero() {
+		tmp.Date = r.Date.Format("2006-01-02")
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Date is missing or the Volume is negative.
+func (r *Recent) Validate() error {
+	switch {
+	case r.Date.IsZero():
+		return fmt.Errorf("date is missing")
+	case r.Volume < 0:
+		return fmt.Errorf("volume is negative")
+	}
+	return nil
+}
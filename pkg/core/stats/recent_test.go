@@ -0,0 +1,77 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stats_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stats"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Recent", func() {
+	var expected []Recent
+	BeforeEach(func() {
+		expected = []Recent{{
+			Date:         time.Date(2021, time.July, 8, 0, 0, 0, 0, time.UTC),
+			Volume:       289375214,
+			RoutedVolume: 40128345,
+			MarketShare:  0.02618,
+			LitVolume:    78812209,
+		}, {
+			Date:         time.Date(2021, time.July, 2, 0, 0, 0, 0, time.UTC),
+			Volume:       201436025,
+			RoutedVolume: 28905137,
+			MarketShare:  0.0247,
+			IsHalfday:    true,
+			LitVolume:    51920716,
+		}}
+	})
+
+	It("should parse recent stats correctly", func() {
+		var res []Recent
+		helper.TestdataFromJSON("core/stats/recent.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenRecent()) {
+			helper.ToGolden("recent", expected)
+			Fail(cmp.Diff(expected, GoldenRecent()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Recent is valid", func() {
+			for idx := range expected {
+				Expect(expected[idx].Validate()).To(Succeed())
+			}
+		})
+		It("should return an error if the Date is missing", func() {
+			expected[0].Date = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("date is missing"))
+		})
+		It("should return an error if the Volume is negative", func() {
+			expected[0].Volume = -1
+			Expect(expected[0].Validate()).To(MatchError("volume is negative"))
+		})
+	})
+})
@@ -0,0 +1,92 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Records represents the data returned by the records stats endpoint: the IEX
+// exchange's best day for each statistic, along with recent values for comparison.
+// https://iexcloud.io/docs/api/#records
+type Records struct {
+	Volume        Record `json:"volume"`
+	SymbolsTraded Record `json:"symbolsTraded"`
+	RoutedVolume  Record `json:"routedVolume"`
+	Notional      Record `json:"notional"`
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if any of the records is missing its date.
+func (r *Records) Validate() error {
+	switch {
+	case r.Volume.RecordDate.IsZero():
+		return fmt.Errorf("volume record date is missing")
+	case r.SymbolsTraded.RecordDate.IsZero():
+		return fmt.Errorf("symbols traded record date is missing")
+	case r.RoutedVolume.RecordDate.IsZero():
+		return fmt.Errorf("routed volume record date is missing")
+	case r.Notional.RecordDate.IsZero():
+		return fmt.Errorf("notional record date is missing")
+	}
+	return nil
+}
+
+// Record is the record value of a statistic, the day it was set, and the
+// statistic's value on the previous day and averaged over the last 30 days.
+type Record struct {
+	RecordValue      float64   `json:"recordValue"`
+	RecordDate       time.Time `json:"-"`
+	PreviousDayValue float64   `json:"previousDayValue"`
+	Avg30Value       float64   `json:"avg30Value"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the recordDate field, which is specified as YYYY-MM-DD,
+// into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (r *Record) UnmarshalJSON(data []byte) (err error) {
+	type record Record
+	type embedded struct {
+		record
+		RecordDate string `json:"recordDate"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*r = Record(tmp.record)
+		r.RecordDate, _ = time.Parse("2006-01-02", tmp.RecordDate) // nolint:errcheck
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (r *Record) MarshalJSON() ([]byte, error) {
+	type record Record
+	type embedded struct {
+		record
+		RecordDate string `json:"recordDate,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.record = record(*r)
+	if !r.RecordDate.IsZero() {
+		tmp.RecordDate = r.RecordDate.Format("2006-01-02")
+	}
+	return json.Marshal(tmp)
+}
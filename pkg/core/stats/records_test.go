@@ -0,0 +1,96 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stats_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stats"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Records", func() {
+	var expected *Records
+	BeforeEach(func() {
+		jan27 := time.Date(2021, time.January, 27, 0, 0, 0, 0, time.UTC)
+		expected = &Records{
+			Volume: Record{
+				RecordValue:      1203481036,
+				RecordDate:       jan27,
+				PreviousDayValue: 289375214,
+				Avg30Value:       265730281.4,
+			},
+			SymbolsTraded: Record{
+				RecordValue:      11452,
+				RecordDate:       time.Date(2021, time.June, 25, 0, 0, 0, 0, time.UTC),
+				PreviousDayValue: 10631,
+				Avg30Value:       10674.37,
+			},
+			RoutedVolume: Record{
+				RecordValue:      209810232,
+				RecordDate:       jan27,
+				PreviousDayValue: 40128345,
+				Avg30Value:       37613250.23,
+			},
+			Notional: Record{
+				RecordValue:      30982541260.36,
+				RecordDate:       jan27,
+				PreviousDayValue: 11532791022.02,
+				Avg30Value:       10915234011.88,
+			},
+		}
+	})
+
+	It("should parse records correctly", func() {
+		var res *Records
+		helper.TestdataFromJSON("core/stats/records.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should have a current golden file", func() {
+		if !cmp.Equal(expected, GoldenRecords()) {
+			helper.ToGolden("records", expected)
+			Fail(cmp.Diff(expected, GoldenRecords()))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Records are valid", func() {
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should return an error if the volume record date is missing", func() {
+			expected.Volume.RecordDate = time.Time{}
+			Expect(expected.Validate()).To(MatchError("volume record date is missing"))
+		})
+		It("should return an error if the symbols traded record date is missing", func() {
+			expected.SymbolsTraded.RecordDate = time.Time{}
+			Expect(expected.Validate()).To(MatchError("symbols traded record date is missing"))
+		})
+		It("should return an error if the routed volume record date is missing", func() {
+			expected.RoutedVolume.RecordDate = time.Time{}
+			Expect(expected.Validate()).To(MatchError("routed volume record date is missing"))
+		})
+		It("should return an error if the notional record date is missing", func() {
+			expected.Notional.RecordDate = time.Time{}
+			Expect(expected.Validate()).To(MatchError("notional record date is missing"))
+		})
+	})
+})
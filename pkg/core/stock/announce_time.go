@@ -0,0 +1,112 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AnnounceTime is the time of day an earnings announcement is made relative to the trading session.
+type AnnounceTime string
+
+const (
+	AnnounceTimeBeforeOpen  AnnounceTime = "BTO" // before the open
+	AnnounceTimeDuringTrade AnnounceTime = "DMT" // during market trading
+	AnnounceTimeAfterClose  AnnounceTime = "AMC" // after the market close
+)
+
+// These are the approximate times of day, in America/New_York, used by Earning.AnnouncedAt.
+// Most companies report shortly before the open or shortly after the close.
+var (
+	AnnounceHourBeforeOpen  = 8
+	AnnounceHourDuringTrade = 12
+	AnnounceHourAfterClose  = 16
+)
+
+// IsValid returns true if the AnnounceTime is one of the values documented by IEX.
+func (a AnnounceTime) IsValid() bool {
+	switch a {
+	case AnnounceTimeBeforeOpen, AnnounceTimeDuringTrade, AnnounceTimeAfterClose:
+		return true
+	}
+	return false
+}
+
+// hour returns the approximate hour of the announcement and whether the AnnounceTime is valid.
+func (a AnnounceTime) hour() (int, bool) {
+	switch a {
+	case AnnounceTimeBeforeOpen:
+		return AnnounceHourBeforeOpen, true
+	case AnnounceTimeDuringTrade:
+		return AnnounceHourDuringTrade, true
+	case AnnounceTimeAfterClose:
+		return AnnounceHourAfterClose, true
+	}
+	return 0, false
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// The value is upper cased, and null is treated as an empty string. Unknown values
+// are kept as-is so they can be detected with IsValid.
+func (a *AnnounceTime) UnmarshalJSON(data []byte) (err error) {
+	var tmp *string
+	if err = json.Unmarshal(data, &tmp); err == nil && tmp != nil {
+		*a = AnnounceTime(strings.ToUpper(strings.TrimSpace(*tmp)))
+	}
+	return
+}
+
+func (a *AnnounceTime) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		*a = AnnounceTime(v)
+	case string:
+		*a = AnnounceTime(v)
+	case nil:
+		*a = ""
+	default:
+		return fmt.Errorf("cannot scan %T into AnnounceTime", value)
+	}
+	return nil
+}
+
+func (a AnnounceTime) Value() (driver.Value, error) {
+	return string(a), nil
+}
+
+// newYork is the location of announcement times. It is loaded once, and newYorkErr is
+// set if the system has no time zone database; importing time/tzdata fixes that.
+var newYork, newYorkErr = time.LoadLocation("America/New_York")
+
+// announcedAt returns the approximate time of an announcement made on date at the given
+// AnnounceTime, in America/New_York.
+func announcedAt(date time.Time, at AnnounceTime) (time.Time, error) {
+	hour, ok := at.hour()
+	switch {
+	case !ok:
+		return time.Time{}, fmt.Errorf("unknown announce time %q", at)
+	case date.IsZero():
+		return time.Time{}, fmt.Errorf("missing report date")
+	case newYorkErr != nil:
+		return time.Time{}, fmt.Errorf("could not load the America/New_York time zone: %w", newYorkErr)
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), hour, 0, 0, 0, newYork), nil
+}
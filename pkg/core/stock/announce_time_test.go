@@ -0,0 +1,50 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+)
+
+var _ = Describe("AnnounceTime", func() {
+	It("should unmarshal and normalize JSON strings", func() {
+		var res []AnnounceTime
+		Expect(json.Unmarshal([]byte(`["BTO", "dmt", " AMC ", null]`), &res)).To(Succeed())
+		Expect(res).To(Equal([]AnnounceTime{AnnounceTimeBeforeOpen, AnnounceTimeDuringTrade, AnnounceTimeAfterClose, ""}))
+	})
+	It("should report whether it is valid", func() {
+		Expect(AnnounceTimeBeforeOpen.IsValid()).To(BeTrue())
+		Expect(AnnounceTime("").IsValid()).To(BeFalse())
+		Expect(AnnounceTime("XYZ").IsValid()).To(BeFalse())
+	})
+	It("should scan from the database", func() {
+		var a AnnounceTime
+		Expect(a.Scan([]byte("AMC"))).To(Succeed())
+		Expect(a).To(Equal(AnnounceTimeAfterClose))
+		Expect(a.Scan("BTO")).To(Succeed())
+		Expect(a).To(Equal(AnnounceTimeBeforeOpen))
+		Expect(a.Scan(1)).ToNot(Succeed())
+	})
+	It("should write its string value to the database", func() {
+		Expect(AnnounceTimeDuringTrade.Value()).To(Equal("DMT"))
+	})
+})
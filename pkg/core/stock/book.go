@@ -0,0 +1,161 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Book represents the data returned by the Book endpoint: the IEX bids and asks,
+// the most recent IEX trades, and the quote for the symbol.
+// https://iexcloud.io/docs/api/#book
+type Book struct {
+	Quote  *Quote      `json:"quote,omitempty"`
+	Bids   []BookLevel `json:"bids"`
+	Asks   []BookLevel `json:"asks"`
+	Trades []Trade     `json:"trades"`
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Quote is missing or invalid.
+func (b *Book) Validate() error {
+	if b.Quote == nil {
+		return fmt.Errorf("quote is missing")
+	}
+	return b.Quote.Validate()
+}
+
+// BookLevel represents one price level on one side of a Book.
+type BookLevel struct {
+	Price     float64   `json:"price"`
+	Size      float64   `json:"size"`
+	Timestamp time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the timestamp field, which is specified in
+// milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (b *BookLevel) UnmarshalJSON(data []byte) (err error) {
+	type level BookLevel
+	type embedded struct {
+		level
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*b = BookLevel(tmp.level)
+		if tmp.Timestamp > 0 {
+			b.Timestamp = time.Unix(tmp.Timestamp/1000, tmp.Timestamp%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (b *BookLevel) MarshalJSON() ([]byte, error) {
+	type level BookLevel
+	type embedded struct {
+		level
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.level = level(*b)
+	if !b.Timestamp.IsZero() {
+		tmp.Timestamp = b.Timestamp.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Trade represents a trade from the Book or Largest Trades endpoints.
+// The Book endpoint populates the trade ID and flags, while the Largest Trades endpoint
+// populates the venue and time label. The timestamp is read from either the "timestamp"
+// or the "time" field, whichever is present.
+// https://iexcloud.io/docs/api/#book
+// https://iexcloud.io/docs/api/#largest-trades
+type Trade struct {
+	Price                 float64   `json:"price"`
+	Size                  float64   `json:"size"`
+	TradeID               int64     `json:"tradeId,omitempty"`
+	IsISO                 bool      `json:"isISO,omitempty"`
+	IsOddLot              bool      `json:"isOddLot,omitempty"`
+	IsOutsideRegularHours bool      `json:"isOutsideRegularHours,omitempty"`
+	IsSinglePriceCross    bool      `json:"isSinglePriceCross,omitempty"`
+	IsTradeThroughExempt  bool      `json:"isTradeThroughExempt,omitempty"`
+	Timestamp             time.Time `json:"-"`
+	TimeLabel             string    `json:"timeLabel,omitempty"`
+	Venue                 string    `json:"venue,omitempty"`
+	VenueName             string    `json:"venueName,omitempty"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the timestamp or time field, which is specified in
+// milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (t *Trade) UnmarshalJSON(data []byte) (err error) {
+	type trade Trade
+	type embedded struct {
+		trade
+		Timestamp int64 `json:"timestamp,omitempty"`
+		Time      int64 `json:"time,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*t = Trade(tmp.trade)
+		ms := tmp.Timestamp
+		if ms == 0 {
+			ms = tmp.Time
+		}
+		if ms > 0 {
+			t.Timestamp = time.Unix(ms/1000, ms%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does, always writing the timestamp to the "timestamp" field.
+func (t *Trade) MarshalJSON() ([]byte, error) {
+	type trade Trade
+	type embedded struct {
+		trade
+		Timestamp int64 `json:"timestamp,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.trade = trade(*t)
+	if !t.Timestamp.IsZero() {
+		tmp.Timestamp = t.Timestamp.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Price, Size, or Timestamp fields are equal to their zero value.
+func (t *Trade) Validate() error {
+	switch {
+	case t.Price == 0:
+		return fmt.Errorf("price is zero")
+	case t.Size == 0:
+		return fmt.Errorf("size is zero")
+	case t.Timestamp.IsZero():
+		return fmt.Errorf("timestamp is missing")
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Book", func() {
+	var expected *Book
+	BeforeEach(func() {
+		f := func(i float64) *float64 { return &i }
+		expected = &Book{
+			Quote: &Quote{
+				Symbol:           "AAPL",
+				CompanyName:      "Apple Inc",
+				CalculationPrice: "tops",
+				LatestPrice:      143.27,
+				LatestSource:     "IEX real time price",
+				LatestTime:       "11:21:37 AM",
+				LatestUpdate:     time.Date(2021, time.July, 8, 15, 21, 37, 912*1e6, time.UTC),
+				IEXRealtimePrice: f(143.27),
+				IEXRealtimeSize:  f(100),
+				IEXLastUpdated:   time.Date(2021, time.July, 8, 15, 21, 37, 912*1e6, time.UTC),
+				PreviousClose:    144.57,
+				IEXBidPrice:      f(143.26),
+				IEXBidSize:       f(200),
+				IEXAskPrice:      f(143.28),
+				IEXAskSize:       f(300),
+				IsUSMarketOpen:   true,
+			},
+			Bids: []BookLevel{{
+				Price:     143.26,
+				Size:      200,
+				Timestamp: time.Date(2021, time.July, 8, 15, 21, 37, 1*1e6, time.UTC),
+			}, {
+				Price:     143.2,
+				Size:      100,
+				Timestamp: time.Date(2021, time.July, 8, 15, 21, 30, 123*1e6, time.UTC),
+			}},
+			Asks: []BookLevel{{
+				Price:     143.28,
+				Size:      300,
+				Timestamp: time.Date(2021, time.July, 8, 15, 21, 37, 450*1e6, time.UTC),
+			}},
+			Trades: []Trade{{
+				Price:     143.27,
+				Size:      100,
+				TradeID:   517341294,
+				Timestamp: time.Date(2021, time.July, 8, 15, 21, 37, 912*1e6, time.UTC),
+			}},
+		}
+	})
+
+	It("should parse the book correctly", func() {
+		var res *Book
+		helper.TestdataFromJSON("core/stock/book.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenBook()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("book", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Book is valid", func() {
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should return an error if the Quote is missing", func() {
+			expected.Quote = nil
+			Expect(expected.Validate()).To(MatchError("quote is missing"))
+		})
+		It("should validate the Quote", func() {
+			expected.Quote.Symbol = ""
+			Expect(expected.Validate()).To(MatchError("symbol is missing"))
+		})
+	})
+})
+
+var _ = Describe("Trade", func() {
+	var expected []Trade
+	BeforeEach(func() {
+		expected = []Trade{{
+			Price:     143.24,
+			Size:      4370165,
+			Timestamp: time.Date(2021, time.July, 8, 20, 0, 0, 490*1e6, time.UTC),
+			TimeLabel: "16:00:00",
+			Venue:     "NASDAQ",
+			VenueName: "Nasdaq",
+		}, {
+			Price:     143.75,
+			Size:      1017289,
+			Timestamp: time.Date(2021, time.July, 8, 13, 30, 0, 529*1e6, time.UTC),
+			TimeLabel: "09:30:00",
+			Venue:     "NASDAQ",
+			VenueName: "Nasdaq",
+		}}
+	})
+
+	It("should parse largest trades correctly", func() {
+		var res []Trade
+		helper.TestdataFromJSON("core/stock/largest_trades.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenLargestTrades()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("largest_trade", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Trade is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the Price is zero", func() {
+			expected[0].Price = 0
+			Expect(expected[0].Validate()).To(MatchError("price is zero"))
+		})
+		It("should return an error if the Size is zero", func() {
+			expected[0].Size = 0
+			Expect(expected[0].Validate()).To(MatchError("size is zero"))
+		})
+		It("should return an error if the Timestamp is zero valued", func() {
+			expected[0].Timestamp = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("timestamp is missing"))
+		})
+	})
+})
@@ -0,0 +1,441 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// isCancelled returns true if a corporate action flag marks the event as withdrawn.
+func isCancelled(flag string) bool {
+	flag = strings.ToLower(flag)
+	return strings.HasPrefix(flag, "cancel") || strings.HasPrefix(flag, "delete")
+}
+
+// AdvancedDividend represents a data point from the advanced dividends time series.
+// It is a superset of the Dividend type; see ToDividend.
+// https://iexcloud.io/docs/api/#dividends
+type AdvancedDividend struct {
+	Symbol              string    `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	ExDate              time.Time `json:"-" gorm:"primaryKey;type:date"`
+	RecordDate          time.Time `json:"-" gorm:"type:date"`
+	PaymentDate         time.Time `json:"-" gorm:"type:date"`
+	AnnounceDate        time.Time `json:"-" gorm:"type:date"`
+	DeclaredDate        time.Time `json:"-" gorm:"type:date"`
+	SecondExDate        time.Time `json:"-" gorm:"type:date"`
+	SecondPaymentDate   time.Time `json:"-" gorm:"type:date"`
+	FXDate              time.Time `json:"-" gorm:"type:date"`
+	Currency            string    `json:"currency,omitempty" gorm:"type:character varying"`
+	Frequency           string    `json:"frequency,omitempty" gorm:"type:character varying"`
+	PaymentType         string    `json:"paymentType,omitempty" gorm:"type:character varying"`
+	Amount              float64   `json:"amount,omitempty" gorm:"type:double precision"`
+	GrossAmount         float64   `json:"grossAmount,omitempty" gorm:"type:double precision"`
+	NetAmount           float64   `json:"netAmount,omitempty" gorm:"type:double precision"`
+	TaxRate             float64   `json:"taxRate,omitempty" gorm:"type:double precision"`
+	ADRFee              float64   `json:"adrFee,omitempty" gorm:"type:double precision"`
+	Coupon              float64   `json:"coupon,omitempty" gorm:"type:double precision"`
+	DeclaredCurrencyCD  string    `json:"declaredCurrencyCD,omitempty" gorm:"type:character varying"`
+	DeclaredGrossAmount float64   `json:"declaredGrossAmount,omitempty" gorm:"type:double precision"`
+	FromFactor          float64   `json:"fromFactor,omitempty" gorm:"type:double precision"`
+	ToFactor            float64   `json:"toFactor,omitempty" gorm:"type:double precision"`
+	Description         string    `json:"description,omitempty" gorm:"type:character varying"`
+	Flag                string    `json:"flag,omitempty" gorm:"type:character varying"`
+	Marker              string    `json:"marker,omitempty" gorm:"type:character varying"`
+	SecurityType        string    `json:"securityType,omitempty" gorm:"type:character varying"`
+	Notes               string    `json:"notes,omitempty" gorm:"type:text"`
+	FIGI                string    `json:"figi,omitempty" gorm:"type:character varying"`
+	CountryCode         string    `json:"countryCode,omitempty" gorm:"type:character varying"`
+	ParValue            float64   `json:"parValue,omitempty" gorm:"type:double precision"`
+	ParValueCurrency    string    `json:"parValueCurrency,omitempty" gorm:"type:character varying"`
+	LastUpdated         time.Time `json:"-" gorm:"type:date"`
+	RefID               int64     `json:"refid,omitempty"`
+	ID                  string    `json:"id,omitempty" gorm:"-"`
+	Source              string    `json:"source,omitempty" gorm:"-"`
+	Key                 string    `json:"key,omitempty" gorm:"-"`
+	Subkey              string    `json:"subkey,omitempty" gorm:"-"`
+	Date                time.Time `json:"-" gorm:"type:date"`
+	Updated             time.Time `json:"-"`
+}
+
+// advancedDividendDates holds the JSON representation of the AdvancedDividend time fields.
+type advancedDividendDates struct {
+	ExDate            string `json:"exDate,omitempty"`
+	RecordDate        string `json:"recordDate,omitempty"`
+	PaymentDate       string `json:"paymentDate,omitempty"`
+	AnnounceDate      string `json:"announceDate,omitempty"`
+	DeclaredDate      string `json:"declaredDate,omitempty"`
+	SecondExDate      string `json:"secondExDate,omitempty"`
+	SecondPaymentDate string `json:"secondPaymentDate,omitempty"`
+	FXDate            string `json:"fxDate,omitempty"`
+	LastUpdated       string `json:"lastUpdated,omitempty"`
+	Date              int64  `json:"date,omitempty"`
+	Updated           int64  `json:"updated,omitempty"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date fields, which are specified as "YYYY-MM-DD",
+// and the date and updated fields, which are specified in milliseconds since the epoch, into time.Times.
+// It will return an error if the JSON cannot be unmarshaled, but NOT if the date parsing fails.
+func (d *AdvancedDividend) UnmarshalJSON(data []byte) (err error) {
+	type advancedDividend AdvancedDividend
+	type embedded struct {
+		advancedDividend
+		advancedDividendDates
+	}
+	tmp := new(embedded)
+	dates := &tmp.advancedDividendDates
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*d = AdvancedDividend(tmp.advancedDividend)
+		d.ExDate, _ = time.Parse("2006-01-02", dates.ExDate)                       // nolint:errcheck
+		d.RecordDate, _ = time.Parse("2006-01-02", dates.RecordDate)               // nolint:errcheck
+		d.PaymentDate, _ = time.Parse("2006-01-02", dates.PaymentDate)             // nolint:errcheck
+		d.AnnounceDate, _ = time.Parse("2006-01-02", dates.AnnounceDate)           // nolint:errcheck
+		d.DeclaredDate, _ = time.Parse("2006-01-02", dates.DeclaredDate)           // nolint:errcheck
+		d.SecondExDate, _ = time.Parse("2006-01-02", dates.SecondExDate)           // nolint:errcheck
+		d.SecondPaymentDate, _ = time.Parse("2006-01-02", dates.SecondPaymentDate) // nolint:errcheck
+		d.FXDate, _ = time.Parse("2006-01-02", dates.FXDate)                       // nolint:errcheck
+		d.LastUpdated, _ = time.Parse("2006-01-02", dates.LastUpdated)             // nolint:errcheck
+		if dates.Date > 0 {
+			d.Date = time.Unix(dates.Date/1000, dates.Date%1000*1e6) // nolint:gomnd
+		}
+		if dates.Updated > 0 {
+			d.Updated = time.Unix(dates.Updated/1000, dates.Updated%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (d *AdvancedDividend) MarshalJSON() ([]byte, error) {
+	type advancedDividend AdvancedDividend
+	type embedded struct {
+		advancedDividend
+		advancedDividendDates
+	}
+	tmp := new(embedded)
+	dates := &tmp.advancedDividendDates
+	tmp.advancedDividend = advancedDividend(*d)
+	dates.ExDate = d.ExDate.Format("2006-01-02")
+	dates.RecordDate = d.RecordDate.Format("2006-01-02")
+	dates.PaymentDate = d.PaymentDate.Format("2006-01-02")
+	dates.AnnounceDate = d.AnnounceDate.Format("2006-01-02")
+	dates.DeclaredDate = d.DeclaredDate.Format("2006-01-02")
+	dates.SecondExDate = d.SecondExDate.Format("2006-01-02")
+	dates.SecondPaymentDate = d.SecondPaymentDate.Format("2006-01-02")
+	dates.FXDate = d.FXDate.Format("2006-01-02")
+	dates.LastUpdated = d.LastUpdated.Format("2006-01-02")
+	if !d.Date.IsZero() {
+		dates.Date = d.Date.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !d.Updated.IsZero() {
+		dates.Updated = d.Updated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// IsCancelled returns true if the Flag marks the dividend as cancelled or deleted.
+func (d *AdvancedDividend) IsCancelled() bool {
+	return isCancelled(d.Flag)
+}
+
+// ToDividend returns the fields of the AdvancedDividend that are shared with the basic Dividend.
+// The basic Flag is the type of dividend, such as "Cash", so it is set from the PaymentType
+// when there is one, and from the Flag otherwise.
+func (d *AdvancedDividend) ToDividend() Dividend {
+	flag := d.Flag
+	if d.PaymentType != "" {
+		flag = d.PaymentType
+	}
+	return Dividend{
+		Symbol:       d.Symbol,
+		Amount:       d.Amount,
+		Currency:     d.Currency,
+		DeclaredDate: d.DeclaredDate,
+		Description:  d.Description,
+		ExDate:       d.ExDate,
+		Flag:         flag,
+		Frequency:    d.Frequency,
+		PaymentDate:  d.PaymentDate,
+		RecordDate:   d.RecordDate,
+		RefID:        float64(d.RefID),
+		ID:           d.ID,
+		Source:       d.Source,
+		Key:          d.Key,
+		Subkey:       d.Subkey,
+		Date:         d.Date,
+		Updated:      d.Updated,
+	}
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol, ExDate, or Currency fields are equal to their zero value.
+// Unlike Dividend, a zero Amount is allowed because stock dividends are expressed as factors.
+func (d *AdvancedDividend) Validate() error {
+	switch {
+	case d.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case d.ExDate.IsZero():
+		return fmt.Errorf("ex date is missing")
+	case d.Currency == "":
+		return fmt.Errorf("currency is missing")
+	}
+	return nil
+}
+
+// AdvancedSplit represents a data point from the advanced splits time series.
+// It is a superset of the Split type; see ToSplit.
+// https://iexcloud.io/docs/api/#splits
+type AdvancedSplit struct {
+	Symbol              string    `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	ExDate              time.Time `json:"-" gorm:"primaryKey;type:date"`
+	RecordDate          time.Time `json:"-" gorm:"type:date"`
+	PaymentDate         time.Time `json:"-" gorm:"type:date"`
+	AnnounceDate        time.Time `json:"-" gorm:"type:date"`
+	FromFactor          float64   `json:"fromFactor,omitempty" gorm:"type:double precision"`
+	ToFactor            float64   `json:"toFactor,omitempty" gorm:"type:double precision"`
+	Ratio               float64   `json:"ratio,omitempty" gorm:"type:double precision"`
+	Description         string    `json:"description,omitempty" gorm:"type:character varying"`
+	Flag                string    `json:"flag,omitempty" gorm:"type:character varying"`
+	SecurityType        string    `json:"securityType,omitempty" gorm:"type:character varying"`
+	Notes               string    `json:"notes,omitempty" gorm:"type:text"`
+	FIGI                string    `json:"figi,omitempty" gorm:"type:character varying"`
+	CountryCode         string    `json:"countryCode,omitempty" gorm:"type:character varying"`
+	ParValue            float64   `json:"parValue,omitempty" gorm:"type:double precision"`
+	ParValueCurrency    string    `json:"parValueCurrency,omitempty" gorm:"type:character varying"`
+	OldParValue         float64   `json:"oldParValue,omitempty" gorm:"type:double precision"`
+	OldParValueCurrency string    `json:"oldParValueCurrency,omitempty" gorm:"type:character varying"`
+	LastUpdated         time.Time `json:"-" gorm:"type:date"`
+	RefID               int64     `json:"refid,omitempty"`
+	ID                  string    `json:"id,omitempty" gorm:"-"`
+	Source              string    `json:"source,omitempty" gorm:"-"`
+	Key                 string    `json:"key,omitempty" gorm:"-"`
+	Subkey              string    `json:"subkey,omitempty" gorm:"-"`
+	Date                time.Time `json:"-" gorm:"type:date"`
+	Updated             time.Time `json:"-"`
+}
+
+// corporateActionDates holds the JSON representation of the time fields shared by
+// AdvancedSplit and CorporateAction.
+type corporateActionDates struct {
+	ExDate       string `json:"exDate,omitempty"`
+	RecordDate   string `json:"recordDate,omitempty"`
+	PaymentDate  string `json:"paymentDate,omitempty"`
+	AnnounceDate string `json:"announceDate,omitempty"`
+	LastUpdated  string `json:"lastUpdated,omitempty"`
+	Date         int64  `json:"date,omitempty"`
+	Updated      int64  `json:"updated,omitempty"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date fields, which are specified as "YYYY-MM-DD",
+// and the date and updated fields, which are specified in milliseconds since the epoch, into time.Times.
+// It will return an error if the JSON cannot be unmarshaled, but NOT if the date parsing fails.
+func (s *AdvancedSplit) UnmarshalJSON(data []byte) (err error) { // nolint:dupl
+	type advancedSplit AdvancedSplit
+	type embedded struct {
+		advancedSplit
+		corporateActionDates
+	}
+	tmp := new(embedded)
+	dates := &tmp.corporateActionDates
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*s = AdvancedSplit(tmp.advancedSplit)
+		s.ExDate, _ = time.Parse("2006-01-02", dates.ExDate)             // nolint:errcheck
+		s.RecordDate, _ = time.Parse("2006-01-02", dates.RecordDate)     // nolint:errcheck
+		s.PaymentDate, _ = time.Parse("2006-01-02", dates.PaymentDate)   // nolint:errcheck
+		s.AnnounceDate, _ = time.Parse("2006-01-02", dates.AnnounceDate) // nolint:errcheck
+		s.LastUpdated, _ = time.Parse("2006-01-02", dates.LastUpdated)   // nolint:errcheck
+		if dates.Date > 0 {
+			s.Date = time.Unix(dates.Date/1000, dates.Date%1000*1e6) // nolint:gomnd
+		}
+		if dates.Updated > 0 {
+			s.Updated = time.Unix(dates.Updated/1000, dates.Updated%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (s *AdvancedSplit) MarshalJSON() ([]byte, error) { // nolint:dupl
+	type advancedSplit AdvancedSplit
+	type embedded struct {
+		advancedSplit
+		corporateActionDates
+	}
+	tmp := new(embedded)
+	dates := &tmp.corporateActionDates
+	tmp.advancedSplit = advancedSplit(*s)
+	dates.ExDate = s.ExDate.Format("2006-01-02")
+	dates.RecordDate = s.RecordDate.Format("2006-01-02")
+	dates.PaymentDate = s.PaymentDate.Format("2006-01-02")
+	dates.AnnounceDate = s.AnnounceDate.Format("2006-01-02")
+	dates.LastUpdated = s.LastUpdated.Format("2006-01-02")
+	if !s.Date.IsZero() {
+		dates.Date = s.Date.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !s.Updated.IsZero() {
+		dates.Updated = s.Updated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// IsCancelled returns true if the Flag marks the split as cancelled or deleted.
+func (s *AdvancedSplit) IsCancelled() bool {
+	return isCancelled(s.Flag)
+}
+
+// ToSplit returns the fields of the AdvancedSplit that are shared with the basic Split.
+// The AnnounceDate is used as the DeclaredDate.
+func (s *AdvancedSplit) ToSplit() Split {
+	return Split{
+		DeclaredDate: s.AnnounceDate,
+		Ratio:        s.Ratio,
+		ToFactor:     s.ToFactor,
+		FromFactor:   s.FromFactor,
+		Description:  s.Description,
+		Symbol:       s.Symbol,
+		ExDate:       s.ExDate,
+		ID:           s.ID,
+		Source:       s.Source,
+		Key:          s.Key,
+		Subkey:       s.Subkey,
+		Date:         s.Date,
+		Updated:      s.Updated,
+	}
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the ExDate is equal to its zero value, or if the ToFactor or FromFactor are not positive.
+func (s *AdvancedSplit) Validate() error {
+	switch {
+	case s.ToFactor <= 0:
+		return fmt.Errorf("to factor is not positive")
+	case s.FromFactor <= 0:
+		return fmt.Errorf("from factor is not positive")
+	case s.ExDate.IsZero():
+		return fmt.Errorf("ex date is missing")
+	}
+	return nil
+}
+
+// CorporateAction represents a data point from the advanced bonus issue, distribution,
+// rights issue, and security reclassification time series, which share a schema.
+// https://iexcloud.io/docs/api/#bonus-issue
+type CorporateAction struct {
+	Symbol             string    `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	ExDate             time.Time `json:"-" gorm:"primaryKey;type:date"`
+	RecordDate         time.Time `json:"-" gorm:"type:date"`
+	PaymentDate        time.Time `json:"-" gorm:"type:date"`
+	AnnounceDate       time.Time `json:"-" gorm:"type:date"`
+	FromFactor         float64   `json:"fromFactor,omitempty" gorm:"type:double precision"`
+	ToFactor           float64   `json:"toFactor,omitempty" gorm:"type:double precision"`
+	Ratio              float64   `json:"ratio,omitempty" gorm:"type:double precision"`
+	Description        string    `json:"description,omitempty" gorm:"type:character varying"`
+	Flag               string    `json:"flag,omitempty" gorm:"type:character varying"`
+	SecurityType       string    `json:"securityType,omitempty" gorm:"type:character varying"`
+	ResultSecurityType string    `json:"resultSecurityType,omitempty" gorm:"type:character varying"`
+	Notes              string    `json:"notes,omitempty" gorm:"type:text"`
+	FIGI               string    `json:"figi,omitempty" gorm:"type:character varying"`
+	CountryCode        string    `json:"countryCode,omitempty" gorm:"type:character varying"`
+	ParValue           float64   `json:"parValue,omitempty" gorm:"type:double precision"`
+	ParValueCurrency   string    `json:"parValueCurrency,omitempty" gorm:"type:character varying"`
+	LapsedPremium      float64   `json:"lapsedPremium,omitempty" gorm:"type:double precision"`
+	LastUpdated        time.Time `json:"-" gorm:"type:date"`
+	RefID              int64     `json:"refid,omitempty"`
+	ID                 string    `json:"id,omitempty" gorm:"-"`
+	Source             string    `json:"source,omitempty" gorm:"-"`
+	Key                string    `json:"key,omitempty" gorm:"-"`
+	Subkey             string    `json:"subkey,omitempty" gorm:"-"`
+	Date               time.Time `json:"-" gorm:"type:date"`
+	Updated            time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date fields, which are specified as "YYYY-MM-DD",
+// and the date and updated fields, which are specified in milliseconds since the epoch, into time.Times.
+// It will return an error if the JSON cannot be unmarshaled, but NOT if the date parsing fails.
+func (c *CorporateAction) UnmarshalJSON(data []byte) (err error) { // nolint:dupl
+	type corporateAction CorporateAction
+	type embedded struct {
+		corporateAction
+		corporateActionDates
+	}
+	tmp := new(embedded)
+	dates := &tmp.corporateActionDates
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*c = CorporateAction(tmp.corporateAction)
+		c.ExDate, _ = time.Parse("2006-01-02", dates.ExDate)             // nolint:errcheck
+		c.RecordDate, _ = time.Parse("2006-01-02", dates.RecordDate)     // nolint:errcheck
+		c.PaymentDate, _ = time.Parse("2006-01-02", dates.PaymentDate)   // nolint:errcheck
+		c.AnnounceDate, _ = time.Parse("2006-01-02", dates.AnnounceDate) // nolint:errcheck
+		c.LastUpdated, _ = time.Parse("2006-01-02", dates.LastUpdated)   // nolint:errcheck
+		if dates.Date > 0 {
+			c.Date = time.Unix(dates.Date/1000, dates.Date%1000*1e6) // nolint:gomnd
+		}
+		if dates.Updated > 0 {
+			c.Updated = time.Unix(dates.Updated/1000, dates.Updated%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (c *CorporateAction) MarshalJSON() ([]byte, error) { // nolint:dupl
+	type corporateAction CorporateAction
+	type embedded struct {
+		corporateAction
+		corporateActionDates
+	}
+	tmp := new(embedded)
+	dates := &tmp.corporateActionDates
+	tmp.corporateAction = corporateAction(*c)
+	dates.ExDate = c.ExDate.Format("2006-01-02")
+	dates.RecordDate = c.RecordDate.Format("2006-01-02")
+	dates.PaymentDate = c.PaymentDate.Format("2006-01-02")
+	dates.AnnounceDate = c.AnnounceDate.Format("2006-01-02")
+	dates.LastUpdated = c.LastUpdated.Format("2006-01-02")
+	if !c.Date.IsZero() {
+		dates.Date = c.Date.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !c.Updated.IsZero() {
+		dates.Updated = c.Updated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// IsCancelled returns true if the Flag marks the corporate action as cancelled or deleted.
+func (c *CorporateAction) IsCancelled() bool {
+	return isCancelled(c.Flag)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol or ExDate fields are equal to their zero value.
+func (c *CorporateAction) Validate() error {
+	switch {
+	case c.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case c.ExDate.IsZero():
+		return fmt.Errorf("ex date is missing")
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("AdvancedDividend", func() {
+	var expected []AdvancedDividend
+	BeforeEach(func() {
+		expected = []AdvancedDividend{{
+			Symbol:              "AAPL",
+			ExDate:              time.Date(2021, time.May, 7, 0, 0, 0, 0, time.UTC),
+			RecordDate:          time.Date(2021, time.May, 10, 0, 0, 0, 0, time.UTC),
+			PaymentDate:         time.Date(2021, time.May, 13, 0, 0, 0, 0, time.UTC),
+			AnnounceDate:        time.Date(2021, time.April, 28, 0, 0, 0, 0, time.UTC),
+			DeclaredDate:        time.Date(2021, time.April, 28, 0, 0, 0, 0, time.UTC),
+			Currency:            "USD",
+			Frequency:           "quarterly",
+			PaymentType:         "Cash",
+			Amount:              0.22,
+			GrossAmount:         0.22,
+			NetAmount:           0.22,
+			DeclaredCurrencyCD:  "USD",
+			DeclaredGrossAmount: 0.22,
+			Description:         "Ordinary Shares",
+			Flag:                "Cash",
+			Marker:              "Interim",
+			SecurityType:        "Equity Shares",
+			Notes:               "Apple Inc. declared a quarterly cash dividend of $0.22 per share.",
+			FIGI:                "BBG000B9XRY4",
+			CountryCode:         "US",
+			ParValue:            0.00001,
+			ParValueCurrency:    "USD",
+			LastUpdated:         time.Date(2021, time.April, 29, 0, 0, 0, 0, time.UTC),
+			RefID:               2201853,
+			ID:                  "ADVANCED_DIVIDENDS",
+			Source:              "IEX Cloud",
+			Key:                 "AAPL",
+			Subkey:              "2201853",
+			Date:                time.Date(2021, time.May, 7, 0, 0, 0, 0, time.UTC),
+			Updated:             time.Date(2021, time.April, 29, 16, 15, 12, 0, time.UTC),
+		}}
+	})
+
+	It("should parse advanced dividends correctly", func() {
+		var res []AdvancedDividend
+		helper.TestdataFromJSON("core/stock/advanced_dividends.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenAdvancedDividends()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("advanced_dividend", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	It("should marshal to JSON and back", func() {
+		data, err := json.Marshal(&expected[0])
+		Expect(err).ToNot(HaveOccurred())
+		var res AdvancedDividend
+		Expect(json.Unmarshal(data, &res)).To(Succeed())
+		Expect(cmp.Equal(expected[0], res)).To(BeTrue(), cmp.Diff(expected[0], res))
+	})
+
+	It("should convert to a Dividend", func() {
+		d := expected[0].ToDividend()
+		Expect(d.Validate()).To(Succeed())
+		Expect(d.Symbol).To(Equal("AAPL"))
+		Expect(d.Amount).To(Equal(0.22))
+		Expect(d.ExDate).To(Equal(expected[0].ExDate))
+		Expect(d.PaymentDate).To(Equal(expected[0].PaymentDate))
+		Expect(d.RefID).To(Equal(float64(2201853)))
+		Expect(d.Flag).To(Equal("Cash"))
+
+		expected[0].Flag, expected[0].PaymentType = "Cancelled", "Stock"
+		Expect(expected[0].ToDividend().Flag).To(Equal("Stock"))
+		expected[0].PaymentType = ""
+		Expect(expected[0].ToDividend().Flag).To(Equal("Cancelled"))
+	})
+
+	Describe("IsCancelled()", func() {
+		It("should return false for a regular dividend", func() {
+			Expect(expected[0].IsCancelled()).To(BeFalse())
+		})
+		It("should return true for a cancelled dividend", func() {
+			expected[0].Flag = "Cancelled"
+			Expect(expected[0].IsCancelled()).To(BeTrue())
+		})
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the AdvancedDividend is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should succeed if the Amount is zero", func() {
+			expected[0].Amount = 0
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the Symbol is missing", func() {
+			expected[0].Symbol = ""
+			Expect(expected[0].Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the ExDate is missing", func() {
+			expected[0].ExDate = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("ex date is missing"))
+		})
+		It("should return an error if the Currency is missing", func() {
+			expected[0].Currency = ""
+			Expect(expected[0].Validate()).To(MatchError("currency is missing"))
+		})
+	})
+})
+
+var _ = Describe("AdvancedSplit", func() {
+	var expected []AdvancedSplit
+	BeforeEach(func() {
+		expected = []AdvancedSplit{{
+			Symbol:              "AAPL",
+			ExDate:              time.Date(2020, time.August, 31, 0, 0, 0, 0, time.UTC),
+			RecordDate:          time.Date(2020, time.August, 24, 0, 0, 0, 0, time.UTC),
+			PaymentDate:         time.Date(2020, time.August, 28, 0, 0, 0, 0, time.UTC),
+			AnnounceDate:        time.Date(2020, time.July, 30, 0, 0, 0, 0, time.UTC),
+			FromFactor:          1,
+			ToFactor:            4,
+			Ratio:               0.25,
+			Description:         "4-for-1 split",
+			Flag:                "Stock",
+			SecurityType:        "Equity Shares",
+			Notes:               "Apple Inc. announced a four-for-one stock split.",
+			FIGI:                "BBG000B9XRY4",
+			CountryCode:         "US",
+			ParValue:            0.00001,
+			ParValueCurrency:    "USD",
+			OldParValue:         0.00004,
+			OldParValueCurrency: "USD",
+			LastUpdated:         time.Date(2020, time.July, 31, 0, 0, 0, 0, time.UTC),
+			RefID:               2096218,
+			ID:                  "ADVANCED_SPLITS",
+			Source:              "IEX Cloud",
+			Key:                 "AAPL",
+			Subkey:              "2096218",
+			Date:                time.Date(2020, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Updated:             time.Date(2020, time.July, 31, 15, 51, 52, 0, time.UTC),
+		}}
+	})
+
+	It("should parse advanced splits correctly", func() {
+		var res []AdvancedSplit
+		helper.TestdataFromJSON("core/stock/advanced_splits.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenAdvancedSplits()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("advanced_split", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	It("should convert to a Split", func() {
+		s := expected[0].ToSplit()
+		Expect(s.Validate()).To(Succeed())
+		Expect(s.Symbol).To(Equal("AAPL"))
+		Expect(s.DeclaredDate).To(Equal(expected[0].AnnounceDate))
+		Expect(s.ExDate).To(Equal(expected[0].ExDate))
+		Expect(s.ToFactor).To(Equal(4.0))
+		Expect(s.FromFactor).To(Equal(1.0))
+		Expect(s.Ratio).To(Equal(0.25))
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the AdvancedSplit is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the ToFactor is not positive", func() {
+			expected[0].ToFactor = 0
+			Expect(expected[0].Validate()).To(MatchError("to factor is not positive"))
+		})
+		It("should return an error if the FromFactor is not positive", func() {
+			expected[0].FromFactor = -1
+			Expect(expected[0].Validate()).To(MatchError("from factor is not positive"))
+		})
+		It("should return an error if the ExDate is missing", func() {
+			expected[0].ExDate = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("ex date is missing"))
+		})
+	})
+})
+
+var _ = Describe("CorporateAction", func() {
+	var expected []CorporateAction
+	BeforeEach(func() {
+		expected = []CorporateAction{{
+			Symbol:             "TEF",
+			ExDate:             time.Date(2021, time.June, 21, 0, 0, 0, 0, time.UTC),
+			RecordDate:         time.Date(2021, time.June, 22, 0, 0, 0, 0, time.UTC),
+			PaymentDate:        time.Date(2021, time.July, 15, 0, 0, 0, 0, time.UTC),
+			AnnounceDate:       time.Date(2021, time.April, 16, 0, 0, 0, 0, time.UTC),
+			FromFactor:         33,
+			ToFactor:           1,
+			Ratio:              33,
+			Description:        "Scrip dividend",
+			Flag:               "Cancelled",
+			SecurityType:       "ADR",
+			ResultSecurityType: "ADR",
+			Notes:              "Telefonica S.A. bonus issue of one new share for every 33 held.",
+			FIGI:               "BBG000BHTTB6",
+			CountryCode:        "ES",
+			ParValue:           1,
+			ParValueCurrency:   "EUR",
+			LapsedPremium:      0.04,
+			LastUpdated:        time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC),
+			RefID:              2245981,
+			ID:                 "ADVANCED_BONUS",
+			Source:             "IEX Cloud",
+			Key:                "TEF",
+			Subkey:             "2245981",
+			Date:               time.Date(2021, time.June, 21, 0, 0, 0, 0, time.UTC),
+			Updated:            time.Date(2021, time.June, 1, 13, 15, 12, 0, time.UTC),
+		}}
+	})
+
+	It("should parse corporate actions correctly", func() {
+		var res []CorporateAction
+		helper.TestdataFromJSON("core/stock/corporate_actions.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenCorporateActions()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("corporate_action", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	It("should report a cancelled corporate action", func() {
+		Expect(expected[0].IsCancelled()).To(BeTrue())
+		expected[0].Flag = "Stock"
+		Expect(expected[0].IsCancelled()).To(BeFalse())
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the CorporateAction is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the Symbol is missing", func() {
+			expected[0].Symbol = ""
+			Expect(expected[0].Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the ExDate is missing", func() {
+			expected[0].ExDate = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("ex date is missing"))
+		})
+	})
+})
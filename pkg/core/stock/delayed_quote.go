@@ -0,0 +1,96 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DelayedQuote represents the data returned by the Delayed Quote endpoint, which
+// provides the 15 minute delayed market quote for a symbol.
+// https://iexcloud.io/docs/api/#delayed-quote
+type DelayedQuote struct {
+	Symbol           string    `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	DelayedPrice     float64   `json:"delayedPrice,omitempty" gorm:"type:double precision"`
+	DelayedSize      float64   `json:"delayedSize,omitempty" gorm:"type:double precision"`
+	DelayedPriceTime time.Time `json:"-" gorm:"primaryKey"`
+	High             float64   `json:"high,omitempty" gorm:"type:double precision"`
+	Low              float64   `json:"low,omitempty" gorm:"type:double precision"`
+	TotalVolume      float64   `json:"totalVolume,omitempty" gorm:"type:double precision"`
+	ProcessedTime    time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the delayedPriceTime and processedTime fields, which
+// are specified in milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (d *DelayedQuote) UnmarshalJSON(data []byte) (err error) {
+	type delayedQuote DelayedQuote
+	type embedded struct {
+		delayedQuote
+		DelayedPriceTime int64 `json:"delayedPriceTime,omitempty"`
+		ProcessedTime    int64 `json:"processedTime,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*d = DelayedQuote(tmp.delayedQuote)
+		if tmp.DelayedPriceTime > 0 {
+			d.DelayedPriceTime = time.Unix(tmp.DelayedPriceTime/1000, tmp.DelayedPriceTime%1000*1e6) // nolint:gomnd
+		}
+		if tmp.ProcessedTime > 0 {
+			d.ProcessedTime = time.Unix(tmp.ProcessedTime/1000, tmp.ProcessedTime%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (d *DelayedQuote) MarshalJSON() ([]byte, error) {
+	type delayedQuote DelayedQuote
+	type embedded struct {
+		delayedQuote
+		DelayedPriceTime int64 `json:"delayedPriceTime,omitempty"`
+		ProcessedTime    int64 `json:"processedTime,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.delayedQuote = delayedQuote(*d)
+	if !d.DelayedPriceTime.IsZero() {
+		tmp.DelayedPriceTime = d.DelayedPriceTime.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !d.ProcessedTime.IsZero() {
+		tmp.ProcessedTime = d.ProcessedTime.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol, DelayedPrice, or DelayedPriceTime fields are
+// equal to their zero value.
+func (d *DelayedQuote) Validate() error {
+	switch {
+	case d.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case d.DelayedPrice == 0:
+		return fmt.Errorf("delayed price is zero")
+	case d.DelayedPriceTime.IsZero():
+		return fmt.Errorf("delayed price time is missing")
+	}
+	return nil
+}
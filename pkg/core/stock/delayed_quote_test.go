@@ -0,0 +1,76 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("DelayedQuote", func() {
+	var expected *DelayedQuote
+	BeforeEach(func() {
+		expected = &DelayedQuote{
+			Symbol:           "AAPL",
+			DelayedPrice:     144.57,
+			DelayedSize:      100,
+			DelayedPriceTime: time.Date(2021, time.July, 8, 20, 0, 0, 412e6, time.UTC),
+			High:             144.89,
+			Low:              142.66,
+			TotalVolume:      105575458,
+			ProcessedTime:    time.Date(2021, time.July, 8, 20, 15, 1, 211e6, time.UTC),
+		}
+	})
+
+	It("should parse the delayed quote correctly", func() {
+		var res *DelayedQuote
+		helper.TestdataFromJSON("core/stock/delayed_quote.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenDelayedQuote()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("delayed_quote", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the DelayedQuote is valid", func() {
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should return an error if the Symbol is missing", func() {
+			expected.Symbol = ""
+			Expect(expected.Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the DelayedPrice is zero", func() {
+			expected.DelayedPrice = 0
+			Expect(expected.Validate()).To(MatchError("delayed price is zero"))
+		})
+		It("should return an error if the DelayedPriceTime is missing", func() {
+			expected.DelayedPriceTime = time.Time{}
+			Expect(expected.Validate()).To(MatchError("delayed price time is missing"))
+		})
+	})
+})
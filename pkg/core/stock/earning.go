@@ -25,26 +25,26 @@ import (
 // Earning represents a data point from the Earnings endpoint.
 // https://iexcloud.io/docs/api/#earnings
 type Earning struct {
-	Symbol                   string    `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
-	EPSReportDate            time.Time `json:"EPSReportDate,omitempty" gorm:"primaryKey;type:date"`
-	EPSSurpriseDollar        float64   `json:"EPSSurpriseDollar,omitempty" gorm:"type:double precision"`
-	EPSSurpriseDollarPercent float64   `json:"EPSSurpriseDollarPercent,omitempty" gorm:"type:double precision"`
-	ActualEPS                *float64  `json:"actualEPS,omitempty" gorm:"type:double precision"`
-	AnnounceTime             string    `json:"announceTime,omitempty" gorm:"type:character varying"` // TODO: BTO, DMT, AMC
-	ConsensusEPS             *float64  `json:"consensusEPS,omitempty" gorm:"type:double precision"`
-	Currency                 string    `json:"currency,omitempty" gorm:"type:character varying"`
-	FiscalEndDate            time.Time `json:"fiscalEndDate,omitempty" gorm:"type:date"`
-	FiscalPeriod             string    `json:"fiscalPeriod,omitempty" gorm:"type:character varying"`
-	NumberOfEstimates        int       `json:"numberOfEstimates,omitempty"`
-	PeriodType               string    `json:"periodType,omitempty" gorm:"type:character varying"`
-	YearAgo                  *float64  `json:"yearAgo,omitempty" gorm:"type:double precision"`
-	YearAgoChangePercent     float64   `json:"yearAgoChangePercent,omitempty" gorm:"type:double precision"`
-	ID                       string    `json:"id,omitempty" gorm:"-"`
-	Source                   string    `json:"source,omitempty" gorm:"-"`
-	Key                      string    `json:"key,omitempty" gorm:"-"`
-	Subkey                   string    `json:"subkey,omitempty" gorm:"-"`
-	Date                     time.Time `json:"date,omitempty" gorm:"type:date"`
-	Updated                  time.Time `json:"updated,omitempty"`
+	Symbol                   string       `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	EPSReportDate            time.Time    `json:"EPSReportDate,omitempty" gorm:"primaryKey;type:date"`
+	EPSSurpriseDollar        float64      `json:"EPSSurpriseDollar,omitempty" gorm:"type:double precision"`
+	EPSSurpriseDollarPercent float64      `json:"EPSSurpriseDollarPercent,omitempty" gorm:"type:double precision"`
+	ActualEPS                *float64     `json:"actualEPS,omitempty" gorm:"type:double precision"`
+	AnnounceTime             AnnounceTime `json:"announceTime,omitempty" gorm:"type:character varying"`
+	ConsensusEPS             *float64     `json:"consensusEPS,omitempty" gorm:"type:double precision"`
+	Currency                 string       `json:"currency,omitempty" gorm:"type:character varying"`
+	FiscalEndDate            time.Time    `json:"fiscalEndDate,omitempty" gorm:"type:date"`
+	FiscalPeriod             string       `json:"fiscalPeriod,omitempty" gorm:"type:character varying"`
+	NumberOfEstimates        int          `json:"numberOfEstimates,omitempty"`
+	PeriodType               string       `json:"periodType,omitempty" gorm:"type:character varying"`
+	YearAgo                  *float64     `json:"yearAgo,omitempty" gorm:"type:double precision"`
+	YearAgoChangePercent     float64      `json:"yearAgoChangePercent,omitempty" gorm:"type:double precision"`
+	ID                       string       `json:"id,omitempty" gorm:"-"`
+	Source                   string       `json:"source,omitempty" gorm:"-"`
+	Key                      string       `json:"key,omitempty" gorm:"-"`
+	Subkey                   string       `json:"subkey,omitempty" gorm:"-"`
+	Date                     time.Time    `json:"date,omitempty" gorm:"type:date"`
+	Updated                  time.Time    `json:"updated,omitempty"`
 }
 
 // UnmarshalJSON satisfies the json.Unmarshaler interface.
@@ -91,6 +91,14 @@ func (e *Earning) MarshalJSON() ([]byte, error) { // nolint:dupl
 	return json.Marshal(tmp)
 }
 
+// AnnouncedAt returns the approximate time the earnings were announced, in America/New_York,
+// based on the EPSReportDate and AnnounceTime. The hour is taken from the AnnounceHour
+// package variables. It returns an error if either field is missing, the AnnounceTime is
+// unknown, or the time zone cannot be loaded.
+func (e *Earning) AnnouncedAt() (time.Time, error) {
+	return announcedAt(e.EPSReportDate, e.AnnounceTime)
+}
+
 // Validate satisfies the Validator interface.
 // It will return an error if the ActualEPS, ConsensusEPS, or EPSReportDate fields are zero
 func (e *Earning) Validate() error {
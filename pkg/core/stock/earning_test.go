@@ -35,7 +35,7 @@ var _ = Describe("Earning", func() {
 			EPSSurpriseDollar:        0.330098697767743855,
 			EPSSurpriseDollarPercent: 0.1440300992341432,
 			ActualEPS:                func(i float64) *float64 { return &i }(5.07),
-			AnnounceTime:             "AMC",
+			AnnounceTime:             AnnounceTimeAfterClose,
 			ConsensusEPS:             func(i float64) *float64 { return &i }(2.703327),
 			Currency:                 "USD",
 			FiscalEndDate:            time.Date(2020, time.September, 18, 0, 0, 0, 0, time.UTC),
@@ -70,6 +70,35 @@ var _ = Describe("Earning", func() {
 		}
 	})
 
+	Describe("AnnouncedAt()", func() {
+		It("should return the approximate announcement time in New York", func() {
+			loc, err := time.LoadLocation("America/New_York")
+			Expect(err).ToNot(HaveOccurred())
+			at, err := expected[0].AnnouncedAt()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(at).To(BeTemporally("==", time.Date(
+				expected[0].EPSReportDate.Year(), expected[0].EPSReportDate.Month(), expected[0].EPSReportDate.Day(),
+				AnnounceHourAfterClose, 0, 0, 0, loc)))
+			Expect(at.Location()).To(Equal(loc))
+		})
+		It("should use the hour for the AnnounceTime", func() {
+			expected[0].AnnounceTime = AnnounceTimeBeforeOpen
+			at, err := expected[0].AnnouncedAt()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(at.Hour()).To(Equal(AnnounceHourBeforeOpen))
+		})
+		It("should return an error if the AnnounceTime is unknown", func() {
+			expected[0].AnnounceTime = "XYZ"
+			_, err := expected[0].AnnouncedAt()
+			Expect(err).To(MatchError(`unknown announce time "XYZ"`))
+		})
+		It("should return an error if the EPSReportDate is missing", func() {
+			expected[0].EPSReportDate = time.Time{}
+			_, err := expected[0].AnnouncedAt()
+			Expect(err).To(MatchError("missing report date"))
+		})
+	})
+
 	Describe("Validate()", func() {
 		It("should succeed if the Earning is valid", func() {
 			Expect(expected[0].Validate()).To(Succeed())
@@ -0,0 +1,140 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Estimate represents a data point from the Estimates endpoint.
+// Estimates share the FiscalPeriod format of the Earning type, so an
+// EstimateHistory can be used to join the two.
+// https://iexcloud.io/docs/api/#estimates
+type Estimate struct {
+	Symbol            string       `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	ConsensusEPS      *float64     `json:"consensusEPS,omitempty" gorm:"type:double precision"`
+	AnnounceTime      AnnounceTime `json:"announceTime,omitempty" gorm:"type:character varying"`
+	NumberOfEstimates int          `json:"numberOfEstimates,omitempty"`
+	ReportDate        time.Time    `json:"-" gorm:"type:date"`
+	FiscalPeriod      string       `json:"fiscalPeriod,omitempty" gorm:"primaryKey;type:character varying"`
+	FiscalEndDate     time.Time    `json:"-" gorm:"type:date"`
+	Currency          string       `json:"currency,omitempty" gorm:"type:character varying"`
+	PeriodType        string       `json:"periodType,omitempty" gorm:"type:character varying"`
+	ID                string       `json:"id,omitempty" gorm:"-"`
+	Key               string       `json:"key,omitempty" gorm:"-"`
+	Subkey            string       `json:"subkey,omitempty" gorm:"-"`
+	Date              time.Time    `json:"-" gorm:"primaryKey;type:date"`
+	Updated           time.Time    `json:"-"`
+}
+
+// AnnouncedAt returns the approximate time the earnings are expected to be announced,
+// in America/New_York, based on the ReportDate and AnnounceTime. See Earning.AnnouncedAt.
+func (e *Estimate) AnnouncedAt() (time.Time, error) {
+	return announcedAt(e.ReportDate, e.AnnounceTime)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the reportDate and fiscalEndDate fields, which are specified
+// as "YYYY-MM-DD", and the date and updated fields, which are specified in milliseconds since the
+// epoch, into time.Times.
+// It will return an error if the JSON cannot be unmarshaled, but NOT if the date parsing fails.
+func (e *Estimate) UnmarshalJSON(data []byte) (err error) { // nolint:dupl
+	type estimate Estimate
+	type embedded struct {
+		estimate
+		ReportDate    string `json:"reportDate,omitempty"`
+		FiscalEndDate string `json:"fiscalEndDate,omitempty"`
+		Date          int64  `json:"date,omitempty"`
+		Updated       int64  `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*e = Estimate(tmp.estimate)
+		e.ReportDate, _ = time.Parse("2006-01-02", tmp.ReportDate)       // nolint:errcheck
+		e.FiscalEndDate, _ = time.Parse("2006-01-02", tmp.FiscalEndDate) // nolint:errcheck
+		if tmp.Date > 0 {
+			e.Date = time.Unix(tmp.Date/1000, tmp.Date%1000*1e6) // nolint:gomnd
+		}
+		if tmp.Updated > 0 {
+			e.Updated = time.Unix(tmp.Updated/1000, tmp.Updated%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (e *Estimate) MarshalJSON() ([]byte, error) { // nolint:dupl
+	type estimate Estimate
+	type embedded struct {
+		estimate
+		ReportDate    string `json:"reportDate,omitempty"`
+		FiscalEndDate string `json:"fiscalEndDate,omitempty"`
+		Date          int64  `json:"date,omitempty"`
+		Updated       int64  `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.estimate = estimate(*e)
+	tmp.ReportDate = e.ReportDate.Format("2006-01-02")
+	tmp.FiscalEndDate = e.FiscalEndDate.Format("2006-01-02")
+	if !e.Date.IsZero() {
+		tmp.Date = e.Date.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !e.Updated.IsZero() {
+		tmp.Updated = e.Updated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the ConsensusEPS, FiscalPeriod or ReportDate fields are equal to their zero value.
+func (e *Estimate) Validate() error {
+	switch {
+	case e.ConsensusEPS == nil:
+		return fmt.Errorf("consensus EPS is missing")
+	case e.FiscalPeriod == "":
+		return fmt.Errorf("fiscal period is missing")
+	case e.ReportDate.IsZero():
+		return fmt.Errorf("report date is missing")
+	}
+	return nil
+}
+
+// EstimateHistory holds the estimates for one symbol keyed by FiscalPeriod (eg "Q4 2020").
+type EstimateHistory map[string]Estimate
+
+// NewEstimateHistory creates an EstimateHistory from a slice of estimates. If more than one
+// estimate exists for a fiscal period, the one with the latest Updated time is kept.
+func NewEstimateHistory(estimates []Estimate) EstimateHistory {
+	h := make(EstimateHistory, len(estimates))
+	for idx := range estimates {
+		e := estimates[idx]
+		if prev, ok := h[e.FiscalPeriod]; !ok || e.Updated.After(prev.Updated) {
+			h[e.FiscalPeriod] = e
+		}
+	}
+	return h
+}
+
+// ForEarning returns the estimate for the fiscal period of the given earning.
+// The second return value is false if there is no such estimate.
+func (h EstimateHistory) ForEarning(e *Earning) (Estimate, bool) {
+	est, ok := h[e.FiscalPeriod]
+	return est, ok
+}
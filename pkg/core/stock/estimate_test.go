@@ -0,0 +1,104 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Estimate", func() {
+	var expected []Estimate
+	BeforeEach(func() {
+		expected = []Estimate{{
+			ConsensusEPS:      func(i float64) *float64 { return &i }(1.01),
+			AnnounceTime:      AnnounceTimeAfterClose,
+			NumberOfEstimates: 28,
+			ReportDate:        time.Date(2021, time.July, 27, 0, 0, 0, 0, time.UTC),
+			FiscalPeriod:      "Q3 2021",
+			FiscalEndDate:     time.Date(2021, time.June, 30, 0, 0, 0, 0, time.UTC),
+			Currency:          "USD",
+			PeriodType:        "quarterly",
+			Symbol:            "AAPL",
+			ID:                "ESTIMATES",
+			Key:               "AAPL",
+			Subkey:            "Q32021",
+			Date:              time.Date(2021, time.June, 30, 0, 0, 0, 0, time.UTC),
+			Updated:           time.Date(2021, time.July, 6, 13, 15, 46, 0, time.UTC),
+		}}
+	})
+
+	It("should parse estimates correctly", func() {
+		var res = struct {
+			Symbol    string     `json:"symbol"`
+			Estimates []Estimate `json:"estimates"`
+		}{}
+		helper.TestdataFromJSON("core/stock/estimates.json", &res)
+		Expect(cmp.Equal(expected, res.Estimates)).To(BeTrue(), cmp.Diff(expected, res.Estimates))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenEstimates()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("estimate", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Estimate is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the ConsensusEPS is nil", func() {
+			expected[0].ConsensusEPS = nil
+			Expect(expected[0].Validate()).To(MatchError("consensus EPS is missing"))
+		})
+		It("should return an error if the FiscalPeriod is empty", func() {
+			expected[0].FiscalPeriod = ""
+			Expect(expected[0].Validate()).To(MatchError("fiscal period is missing"))
+		})
+		It("should return an error if the ReportDate is zero valued", func() {
+			expected[0].ReportDate = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("report date is missing"))
+		})
+	})
+
+	Describe("EstimateHistory", func() {
+		It("should key estimates by fiscal period and keep the latest update", func() {
+			stale := expected[0]
+			stale.ConsensusEPS = func(i float64) *float64 { return &i }(0.98)
+			stale.Updated = stale.Updated.AddDate(0, 0, -7)
+			history := NewEstimateHistory(append(expected, stale))
+			Expect(history).To(HaveLen(1))
+			Expect(*history["Q3 2021"].ConsensusEPS).To(BeNumerically("==", 1.01))
+		})
+		It("should join with earnings on the fiscal period", func() {
+			history := NewEstimateHistory(expected)
+			est, ok := history.ForEarning(&Earning{FiscalPeriod: "Q3 2021"})
+			Expect(ok).To(BeTrue())
+			Expect(est.NumberOfEstimates).To(Equal(28))
+			_, ok = history.ForEarning(&Earning{FiscalPeriod: "Q2 2021"})
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -47,3 +47,105 @@ func GoldenSplit() (s []Split) {
 	helper.FromGolden("split", &s)
 	return
 }
+
+// GoldenNews returns golden data for the News type
+func GoldenNews() (n []News) {
+	helper.FromGolden("news", &n)
+	return
+}
+
+// GoldenInsiderTransactions returns golden data for the InsiderTransaction type
+func GoldenInsiderTransactions() (i []InsiderTransaction) {
+	helper.FromGolden("insider_transaction", &i)
+	return
+}
+
+// GoldenInsiderRoster returns golden data for the InsiderRosterEntry type
+func GoldenInsiderRoster() (i []InsiderRosterEntry) {
+	helper.FromGolden("insider_roster", &i)
+	return
+}
+
+// GoldenInsiderSummary returns golden data for the InsiderSummary type
+func GoldenInsiderSummary() (i []InsiderSummary) {
+	helper.FromGolden("insider_summary", &i)
+	return
+}
+
+// GoldenHolders returns golden data for the Holder type
+func GoldenHolders() (h []Holder) {
+	helper.FromGolden("holder", &h)
+	return
+}
+
+// GoldenEstimates returns golden data for the Estimate type
+func GoldenEstimates() (e []Estimate) {
+	helper.FromGolden("estimate", &e)
+	return
+}
+
+// GoldenPriceTarget returns golden data for the PriceTarget type
+func GoldenPriceTarget() (p *PriceTarget) {
+	helper.FromGolden("price_target", &p)
+	return
+}
+
+// GoldenRecommendationTrends returns golden data for the RecommendationTrend type
+func GoldenRecommendationTrends() (r []RecommendationTrend) {
+	helper.FromGolden("recommendation_trend", &r)
+	return
+}
+
+// GoldenQuote returns golden data for the Quote type
+func GoldenQuote() (q *Quote) {
+	helper.FromGolden("quote", &q)
+	return
+}
+
+// GoldenBook returns golden data for the Book type
+func GoldenBook() (b *Book) {
+	helper.FromGolden("book", &b)
+	return
+}
+
+// GoldenLargestTrades returns golden data for the Trade type
+func GoldenLargestTrades() (t []Trade) {
+	helper.FromGolden("largest_trade", &t)
+	return
+}
+
+// GoldenVenueVolume returns golden data for the VenueVolume type
+func GoldenVenueVolume() (v []VenueVolume) {
+	helper.FromGolden("venue_volume", &v)
+	return
+}
+
+// GoldenAdvancedDividends returns golden data for the AdvancedDividend type
+func GoldenAdvancedDividends() (d []AdvancedDividend) {
+	helper.FromGolden("advanced_dividend", &d)
+	return
+}
+
+// GoldenAdvancedSplits returns golden data for the AdvancedSplit type
+func GoldenAdvancedSplits() (s []AdvancedSplit) {
+	helper.FromGolden("advanced_split", &s)
+	return
+}
+
+// GoldenCorporateActions returns golden data for the CorporateAction type
+func GoldenCorporateActions() (c []CorporateAction) {
+	helper.FromGolden("corporate_action", &c)
+	return
+}
+
+// GoldenDelayedQuote returns golden data for the DelayedQuote type
+func GoldenDelayedQuote() (d *DelayedQuote) {
+	helper.FromGolden("delayed_quote", &d)
+	return
+}
+
+// GoldenOHLC returns golden data for the OHLC type, keyed by symbol
+func GoldenOHLC() (o map[string]OHLC) {
+	helper.FromGolden("ohlc", &o)
+	return
+}
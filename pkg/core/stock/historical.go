@@ -58,6 +58,8 @@ type Historical struct {
 // This function correctly translates the date field, which is specified as "YYYY-MM-DD",
 // into a time.Time by using time.Parse().
 // It will return an error if the JSON cannot be unmarshaled, but NOT if the date parsing fails.
+// Responses requested with chartCloseOnly contain only the date, close and volume and
+// leave the remaining fields, including Updated, zero valued.
 func (h *Historical) UnmarshalJSON(data []byte) (err error) {
 	type historical Historical
 	type embedded struct {
@@ -68,8 +70,10 @@ func (h *Historical) UnmarshalJSON(data []byte) (err error) {
 	tmp := new(embedded)
 	if err = json.Unmarshal(data, tmp); err == nil {
 		*h = Historical(tmp.historical)
-		h.Date, _ = time.Parse("2006-01-02", tmp.Date)                // nolint:errcheck
-		h.Updated = time.Unix(tmp.Updated/1000, tmp.Updated%1000*1e6) // nolint:gomnd
+		h.Date, _ = time.Parse("2006-01-02", tmp.Date) // nolint:errcheck
+		if tmp.Updated > 0 {
+			h.Updated = time.Unix(tmp.Updated/1000, tmp.Updated%1000*1e6) // nolint:gomnd
+		}
 	}
 	return
 }
@@ -86,7 +90,9 @@ func (h *Historical) MarshalJSON() ([]byte, error) {
 	tmp := new(embedded)
 	tmp.historical = historical(*h)
 	tmp.Date = h.Date.Format("2006-01-02")
-	tmp.Updated = h.Updated.UnixNano() / 1e6 // nolint:gomnd
+	if !h.Updated.IsZero() {
+		tmp.Updated = h.Updated.UnixNano() / 1e6 // nolint:gomnd
+	}
 	return json.Marshal(tmp)
 }
 
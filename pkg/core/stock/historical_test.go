@@ -65,6 +65,21 @@ var _ = Describe("Historical", func() { // nolint: dupl
 		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
 	})
 
+	It("should parse close-only historical prices correctly", func() {
+		var res []Historical
+		helper.TestdataFromJSON("core/stock/historical_close_only.json", &res)
+		closeOnly := []Historical{{
+			Close:  116.59,
+			Volume: func(i float64) *float64 { return &i }(46691331),
+			Date:   time.Date(2020, time.November, 27, 0, 0, 0, 0, time.UTC),
+		}, {
+			Close:  119.05,
+			Volume: func(i float64) *float64 { return &i }(169410176),
+			Date:   time.Date(2020, time.November, 30, 0, 0, 0, 0, time.UTC),
+		}}
+		Expect(cmp.Equal(closeOnly, res)).To(BeTrue(), cmp.Diff(closeOnly, res))
+	})
+
 	It("should match the golden file", func() {
 		golden := GoldenHistorical()
 		golden[0].ChangeOverTime = func(i float64) *float64 { return &i }(-0)
@@ -0,0 +1,194 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Holder represents a data point from the Institutional Ownership and Fund Ownership endpoints,
+// which share a schema. Adjusted values account for splits since the report date, so they are
+// the ones to use when comparing reporting periods.
+// https://iexcloud.io/docs/api/#institutional-ownership
+// https://iexcloud.io/docs/api/#fund-ownership
+type Holder struct {
+	Symbol           string    `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	EntityProperName string    `json:"entityProperName,omitempty" gorm:"primaryKey;type:character varying"`
+	ReportDate       time.Time `json:"-" gorm:"primaryKey;type:date"`
+	ReportedHolding  float64   `json:"reportedHolding,omitempty" gorm:"type:double precision"`
+	AdjHolding       float64   `json:"adjHolding,omitempty" gorm:"type:double precision"`
+	ReportedMV       float64   `json:"reportedMv,omitempty" gorm:"type:double precision"`
+	AdjMV            float64   `json:"adjMv,omitempty" gorm:"type:double precision"`
+	ID               string    `json:"id,omitempty" gorm:"-"`
+	Key              string    `json:"key,omitempty" gorm:"-"`
+	Subkey           string    `json:"subkey,omitempty" gorm:"-"`
+	Date             time.Time `json:"-" gorm:"type:date"`
+	Updated          time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the reportDate, date, and updated fields, which are
+// specified in milliseconds since the epoch, into time.Times.
+// It will return an error if the JSON cannot be unmarshaled.
+func (h *Holder) UnmarshalJSON(data []byte) (err error) {
+	type holder Holder
+	type embedded struct {
+		holder
+		ReportDate int64 `json:"reportDate,omitempty"`
+		Date       int64 `json:"date,omitempty"`
+		Updated    int64 `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*h = Holder(tmp.holder)
+		if tmp.ReportDate > 0 {
+			h.ReportDate = time.Unix(tmp.ReportDate/1000, tmp.ReportDate%1000*1e6) // nolint:gomnd
+		}
+		if tmp.Date > 0 {
+			h.Date = time.Unix(tmp.Date/1000, tmp.Date%1000*1e6) // nolint:gomnd
+		}
+		if tmp.Updated > 0 {
+			h.Updated = time.Unix(tmp.Updated/1000, tmp.Updated%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (h *Holder) MarshalJSON() ([]byte, error) {
+	type holder Holder
+	type embedded struct {
+		holder
+		ReportDate int64 `json:"reportDate,omitempty"`
+		Date       int64 `json:"date,omitempty"`
+		Updated    int64 `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.holder = holder(*h)
+	if !h.ReportDate.IsZero() {
+		tmp.ReportDate = h.ReportDate.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !h.Date.IsZero() {
+		tmp.Date = h.Date.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !h.Updated.IsZero() {
+		tmp.Updated = h.Updated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the EntityProperName or ReportDate fields are equal to their zero value.
+func (h *Holder) Validate() error {
+	switch {
+	case h.EntityProperName == "":
+		return fmt.Errorf("entity name is missing")
+	case h.ReportDate.IsZero():
+		return fmt.Errorf("report date is missing")
+	}
+	return nil
+}
+
+// PositionChange describes how a holder's position changed between two reporting periods.
+type PositionChange string
+
+// These are the possible values of a PositionChange.
+const (
+	PositionNew       PositionChange = "new"
+	PositionClosed    PositionChange = "closed"
+	PositionIncreased PositionChange = "increased"
+	PositionDecreased PositionChange = "decreased"
+	PositionUnchanged PositionChange = "unchanged"
+)
+
+// HoldingChange is the difference in one holder's position in one symbol between two reporting periods.
+// Previous is nil for new positions and Current is nil for closed positions.
+type HoldingChange struct {
+	Symbol           string
+	EntityProperName string
+	Change           PositionChange
+	Previous         *Holder
+	Current          *Holder
+}
+
+// Shares returns the change in the adjusted number of shares held.
+func (h HoldingChange) Shares() (shares float64) {
+	if h.Current != nil {
+		shares += h.Current.AdjHolding
+	}
+	if h.Previous != nil {
+		shares -= h.Previous.AdjHolding
+	}
+	return shares
+}
+
+// CompareHoldings compares two reporting periods and returns one HoldingChange per holder and symbol,
+// sorted by symbol and then entity name. Holders are matched on Symbol and EntityProperName, and
+// positions are compared using AdjHolding so that splits between the periods do not register as
+// changes. A holder that appears in current with a zero AdjHolding is treated as closed.
+func CompareHoldings(previous, current []Holder) []HoldingChange {
+	type key struct{ symbol, entity string }
+	changes := make(map[key]*HoldingChange)
+	get := func(h *Holder) *HoldingChange {
+		k := key{h.Symbol, h.EntityProperName}
+		if _, ok := changes[k]; !ok {
+			changes[k] = &HoldingChange{Symbol: h.Symbol, EntityProperName: h.EntityProperName}
+		}
+		return changes[k]
+	}
+	for idx := range previous {
+		get(&previous[idx]).Previous = &previous[idx]
+	}
+	for idx := range current {
+		get(&current[idx]).Current = &current[idx]
+	}
+
+	res := make([]HoldingChange, 0, len(changes))
+	for _, c := range changes {
+		var prev, cur float64
+		if c.Previous != nil {
+			prev = c.Previous.AdjHolding
+		}
+		if c.Current != nil {
+			cur = c.Current.AdjHolding
+		}
+		switch {
+		case prev == cur:
+			c.Change = PositionUnchanged
+		case prev == 0:
+			c.Change = PositionNew
+		case cur == 0:
+			c.Change = PositionClosed
+		case cur > prev:
+			c.Change = PositionIncreased
+		default:
+			c.Change = PositionDecreased
+		}
+		res = append(res, *c)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Symbol != res[j].Symbol {
+			return res[i].Symbol < res[j].Symbol
+		}
+		return res[i].EntityProperName < res[j].EntityProperName
+	})
+	return res
+}
@@ -0,0 +1,132 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Holder", func() {
+	var expected []Holder
+	BeforeEach(func() {
+		expected = []Holder{{
+			AdjHolding:       1271264644,
+			AdjMV:            168637294038,
+			EntityProperName: "Vanguard Group, Inc. (The)",
+			ReportDate:       time.Date(2021, time.March, 31, 0, 0, 0, 0, time.UTC),
+			ReportedHolding:  1271264644,
+			ReportedMV:       155288981223,
+			Symbol:           "AAPL",
+			ID:               "INSTITUTIONAL_OWNERSHIP",
+			Key:              "AAPL",
+			Subkey:           "1",
+			Date:             time.Date(2021, time.March, 31, 0, 0, 0, 0, time.UTC),
+			Updated:          time.Date(2021, time.May, 12, 14, 35, 54, 0, time.UTC),
+		}, {
+			AdjHolding:       1068016727,
+			AdjMV:            141672208932,
+			EntityProperName: "BlackRock Inc.",
+			ReportDate:       time.Date(2021, time.March, 31, 0, 0, 0, 0, time.UTC),
+			ReportedHolding:  1068016727,
+			ReportedMV:       130458243204,
+			Symbol:           "AAPL",
+			ID:               "INSTITUTIONAL_OWNERSHIP",
+			Key:              "AAPL",
+			Subkey:           "2",
+			Date:             time.Date(2021, time.March, 31, 0, 0, 0, 0, time.UTC),
+			Updated:          time.Date(2021, time.May, 12, 14, 35, 54, 0, time.UTC),
+		}}
+	})
+
+	It("should parse holders correctly", func() {
+		var res []Holder
+		helper.TestdataFromJSON("core/stock/holders.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenHolders()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("holder", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Holder is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the EntityProperName is empty", func() {
+			expected[0].EntityProperName = ""
+			Expect(expected[0].Validate()).To(MatchError("entity name is missing"))
+		})
+		It("should return an error if the ReportDate is zero valued", func() {
+			expected[0].ReportDate = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("report date is missing"))
+		})
+	})
+
+	Describe("CompareHoldings()", func() {
+		var previous, current []Holder
+		BeforeEach(func() {
+			holder := func(entity string, shares float64) Holder {
+				return Holder{Symbol: "AAPL", EntityProperName: entity, AdjHolding: shares}
+			}
+			previous = []Holder{holder("A", 100), holder("B", 100), holder("C", 100), holder("D", 100)}
+			current = []Holder{holder("A", 150), holder("B", 50), holder("C", 100), holder("E", 10), holder("D", 0)}
+		})
+
+		It("should classify each position", func() {
+			res := CompareHoldings(previous, current)
+			Expect(res).To(HaveLen(5))
+			changes := make(map[string]PositionChange)
+			for _, c := range res {
+				changes[c.EntityProperName] = c.Change
+			}
+			Expect(changes).To(Equal(map[string]PositionChange{
+				"A": PositionIncreased,
+				"B": PositionDecreased,
+				"C": PositionUnchanged,
+				"D": PositionClosed,
+				"E": PositionNew,
+			}))
+		})
+		It("should sort the changes and compute the share difference", func() {
+			res := CompareHoldings(previous, current[:4])
+			Expect(res[0].EntityProperName).To(Equal("A"))
+			Expect(res[0].Shares()).To(BeNumerically("==", 50))
+			Expect(res).To(HaveLen(5))
+			Expect(res[4].EntityProperName).To(Equal("E"))
+			Expect(res[4].Previous).To(BeNil())
+			Expect(res[4].Shares()).To(BeNumerically("==", 10))
+		})
+		It("should treat a holder missing from the current period as closed", func() {
+			res := CompareHoldings(previous, nil)
+			for _, c := range res {
+				Expect(c.Change).To(Equal(PositionClosed))
+				Expect(c.Current).To(BeNil())
+			}
+		})
+	})
+})
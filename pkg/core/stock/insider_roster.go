@@ -0,0 +1,80 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// InsiderRosterEntry represents a data point from the Insider Roster endpoint.
+// The endpoint does not return the symbol, so it is up to the caller to track it.
+// https://iexcloud.io/docs/api/#insider-roster
+type InsiderRosterEntry struct {
+	EntityName string    `json:"entityName,omitempty" gorm:"primaryKey;type:character varying"`
+	Position   float64   `json:"position,omitempty" gorm:"type:double precision"`
+	ReportDate time.Time `json:"-" gorm:"primaryKey"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the reportDate field, which is specified
+// in milliseconds since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (i *InsiderRosterEntry) UnmarshalJSON(data []byte) (err error) {
+	type entry InsiderRosterEntry
+	type embedded struct {
+		entry
+		ReportDate int64 `json:"reportDate,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*i = InsiderRosterEntry(tmp.entry)
+		if tmp.ReportDate > 0 {
+			i.ReportDate = time.Unix(tmp.ReportDate/1000, tmp.ReportDate%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (i *InsiderRosterEntry) MarshalJSON() ([]byte, error) {
+	type entry InsiderRosterEntry
+	type embedded struct {
+		entry
+		ReportDate int64 `json:"reportDate,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.entry = entry(*i)
+	if !i.ReportDate.IsZero() {
+		tmp.ReportDate = i.ReportDate.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the EntityName or ReportDate fields are equal to their zero value.
+func (i *InsiderRosterEntry) Validate() error {
+	switch {
+	case i.EntityName == "":
+		return fmt.Errorf("entity name is missing")
+	case i.ReportDate.IsZero():
+		return fmt.Errorf("report date is missing")
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("InsiderRosterEntry", func() {
+	var expected []InsiderRosterEntry
+	BeforeEach(func() {
+		expected = []InsiderRosterEntry{{
+			EntityName: "Timothy Cook",
+			Position:   3279726,
+			ReportDate: time.Date(2021, time.March, 31, 0, 0, 0, 0, time.UTC),
+		}, {
+			EntityName: "Jeffrey Williams",
+			Position:   589944,
+			ReportDate: time.Date(2021, time.April, 6, 0, 0, 0, 0, time.UTC),
+		}}
+	})
+
+	It("should parse the insider roster correctly", func() {
+		var res []InsiderRosterEntry
+		helper.TestdataFromJSON("core/stock/insider_roster.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenInsiderRoster()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("insider_roster", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the InsiderRosterEntry is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the EntityName is empty", func() {
+			expected[0].EntityName = ""
+			Expect(expected[0].Validate()).To(MatchError("entity name is missing"))
+		})
+		It("should return an error if the ReportDate is zero valued", func() {
+			expected[0].ReportDate = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("report date is missing"))
+		})
+	})
+})
@@ -0,0 +1,96 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// InsiderSummary represents a data point from the Insider Summary endpoint.
+// It summarizes the last six months of transactions for each insider.
+// https://iexcloud.io/docs/api/#insider-summary
+type InsiderSummary struct {
+	Symbol        string    `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	FullName      string    `json:"fullName,omitempty" gorm:"primaryKey;type:character varying"`
+	ReportedTitle string    `json:"reportedTitle,omitempty" gorm:"type:character varying"`
+	NetTransacted float64   `json:"netTransacted,omitempty" gorm:"type:double precision"`
+	TotalBought   float64   `json:"totalBought,omitempty" gorm:"type:double precision"`
+	TotalSold     float64   `json:"totalSold,omitempty" gorm:"type:double precision"`
+	ID            string    `json:"id,omitempty" gorm:"-"`
+	Key           string    `json:"key,omitempty" gorm:"-"`
+	Subkey        string    `json:"subkey,omitempty" gorm:"-"`
+	Date          time.Time `json:"-" gorm:"primaryKey;type:date"`
+	Updated       time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date and updated fields, which are specified
+// in milliseconds since the epoch, into time.Times.
+// It will return an error if the JSON cannot be unmarshaled.
+func (i *InsiderSummary) UnmarshalJSON(data []byte) (err error) {
+	type summary InsiderSummary
+	type embedded struct {
+		summary
+		Date    int64 `json:"date,omitempty"`
+		Updated int64 `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*i = InsiderSummary(tmp.summary)
+		if tmp.Date > 0 {
+			i.Date = time.Unix(tmp.Date/1000, tmp.Date%1000*1e6) // nolint:gomnd
+		}
+		if tmp.Updated > 0 {
+			i.Updated = time.Unix(tmp.Updated/1000, tmp.Updated%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (i *InsiderSummary) MarshalJSON() ([]byte, error) {
+	type summary InsiderSummary
+	type embedded struct {
+		summary
+		Date    int64 `json:"date,omitempty"`
+		Updated int64 `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.summary = summary(*i)
+	if !i.Date.IsZero() {
+		tmp.Date = i.Date.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !i.Updated.IsZero() {
+		tmp.Updated = i.Updated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol or FullName fields are equal to their zero value.
+func (i *InsiderSummary) Validate() error {
+	switch {
+	case i.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case i.FullName == "":
+		return fmt.Errorf("full name is missing")
+	}
+	return nil
+}
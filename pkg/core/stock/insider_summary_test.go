@@ -0,0 +1,74 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("InsiderSummary", func() {
+	var expected []InsiderSummary
+	BeforeEach(func() {
+		expected = []InsiderSummary{{
+			FullName:      "Jeffrey Williams",
+			NetTransacted: -100000,
+			ReportedTitle: "COO",
+			Symbol:        "AAPL",
+			TotalSold:     100000,
+			ID:            "INSIDER_SUMMARY",
+			Key:           "AAPL",
+			Subkey:        "0001496686",
+			Date:          time.Date(2021, time.April, 5, 0, 0, 0, 0, time.UTC),
+			Updated:       time.Date(2021, time.April, 8, 0, 0, 45, 0, time.UTC),
+		}}
+	})
+
+	It("should parse the insider summary correctly", func() {
+		var res []InsiderSummary
+		helper.TestdataFromJSON("core/stock/insider_summary.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenInsiderSummary()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("insider_summary", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the InsiderSummary is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the Symbol is empty", func() {
+			expected[0].Symbol = ""
+			Expect(expected[0].Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the FullName is empty", func() {
+			expected[0].FullName = ""
+			Expect(expected[0].Validate()).To(MatchError("full name is missing"))
+		})
+	})
+})
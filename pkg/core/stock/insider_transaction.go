@@ -0,0 +1,267 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// TransactionCode is the SEC Form 4 code that describes an insider transaction.
+// https://www.sec.gov/about/forms/form4data.pdf
+type TransactionCode string
+
+// These are the transaction codes defined in the SEC Form 4 instructions.
+const (
+	TransactionCodePurchase           TransactionCode = "P"
+	TransactionCodeSale               TransactionCode = "S"
+	TransactionCodeVoluntary          TransactionCode = "V"
+	TransactionCodeAward              TransactionCode = "A"
+	TransactionCodeDisposition        TransactionCode = "D"
+	TransactionCodeTaxWithholding     TransactionCode = "F"
+	TransactionCodeDiscretionary      TransactionCode = "I"
+	TransactionCodeExercise           TransactionCode = "M"
+	TransactionCodeConversion         TransactionCode = "C"
+	TransactionCodeExpirationShort    TransactionCode = "E"
+	TransactionCodeExpirationLong     TransactionCode = "H"
+	TransactionCodeExerciseOutOfMoney TransactionCode = "O"
+	TransactionCodeExerciseInTheMoney TransactionCode = "X"
+	TransactionCodeGift               TransactionCode = "G"
+	TransactionCodeSmallAcquisition   TransactionCode = "L"
+	TransactionCodeInheritance        TransactionCode = "W"
+	TransactionCodeVotingTrust        TransactionCode = "Z"
+	TransactionCodeOther              TransactionCode = "J"
+	TransactionCodeEquitySwap         TransactionCode = "K"
+	TransactionCodeTenderOfShares     TransactionCode = "U"
+	TransactionCodeDomesticRelations  TransactionCode = "Q"
+)
+
+var transactionCodeDescriptions = map[TransactionCode]string{
+	TransactionCodePurchase:           "open market or private purchase",
+	TransactionCodeSale:               "open market or private sale",
+	TransactionCodeVoluntary:          "transaction voluntarily reported earlier than required",
+	TransactionCodeAward:              "grant, award or other acquisition",
+	TransactionCodeDisposition:        "disposition to the issuer",
+	TransactionCodeTaxWithholding:     "payment of exercise price or tax liability",
+	TransactionCodeDiscretionary:      "discretionary transaction",
+	TransactionCodeExercise:           "exercise or conversion of derivative security",
+	TransactionCodeConversion:         "conversion of derivative security",
+	TransactionCodeExpirationShort:    "expiration of short derivative position",
+	TransactionCodeExpirationLong:     "expiration of long derivative position",
+	TransactionCodeExerciseOutOfMoney: "exercise of out-of-the-money derivative security",
+	TransactionCodeExerciseInTheMoney: "exercise of in-the-money or at-the-money derivative security",
+	TransactionCodeGift:               "bona fide gift",
+	TransactionCodeSmallAcquisition:   "small acquisition",
+	TransactionCodeInheritance:        "acquisition or disposition by will or the laws of descent",
+	TransactionCodeVotingTrust:        "deposit into or withdrawal from voting trust",
+	TransactionCodeOther:              "other acquisition or disposition",
+	TransactionCodeEquitySwap:         "equity swap or similar instrument",
+	TransactionCodeTenderOfShares:     "disposition pursuant to a tender of shares in a change of control",
+	TransactionCodeDomesticRelations:  "transaction pursuant to a domestic relations order",
+}
+
+// Description returns a short description of the transaction code, or an empty string if the code is unknown.
+func (c TransactionCode) Description() string {
+	return transactionCodeDescriptions[c]
+}
+
+// IsValid returns true if the transaction code is one of those defined by the SEC.
+func (c TransactionCode) IsValid() bool {
+	_, ok := transactionCodeDescriptions[c]
+	return ok
+}
+
+func (c *TransactionCode) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		*c = TransactionCode(v)
+	case string:
+		*c = TransactionCode(v)
+	default:
+		return fmt.Errorf("cannot scan %T into TransactionCode", value)
+	}
+	return nil
+}
+
+func (c TransactionCode) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+// InsiderTransaction represents a data point from the Insider Transactions endpoint.
+// https://iexcloud.io/docs/api/#insider-transactions
+type InsiderTransaction struct {
+	Symbol                    string          `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	FullName                  string          `json:"fullName,omitempty" gorm:"primaryKey;type:character varying"`
+	ReportedTitle             string          `json:"reportedTitle,omitempty" gorm:"type:character varying"`
+	ConversionOrExercisePrice *float64        `json:"conversionOrExercisePrice,omitempty" gorm:"type:double precision"`
+	DirectIndirect            string          `json:"directIndirect,omitempty" gorm:"type:character(1)"`
+	EffectiveDate             time.Time       `json:"-"`
+	FilingDate                time.Time       `json:"-" gorm:"type:date"`
+	Is10b51                   bool            `json:"is10b51"`
+	PostShares                *float64        `json:"postShares,omitempty" gorm:"type:double precision"`
+	TransactionCode           TransactionCode `json:"transactionCode,omitempty" gorm:"primaryKey;type:character(1)"`
+	TransactionDate           time.Time       `json:"-" gorm:"primaryKey;type:date"`
+	TransactionPrice          *float64        `json:"transactionPrice,omitempty" gorm:"type:double precision"`
+	TransactionShares         *float64        `json:"transactionShares,omitempty" gorm:"type:double precision"`
+	TransactionValue          *float64        `json:"transactionValue,omitempty" gorm:"type:double precision"`
+	ID                        string          `json:"id,omitempty" gorm:"-"`
+	Key                       string          `json:"key,omitempty" gorm:"-"`
+	Subkey                    string          `json:"subkey,omitempty" gorm:"-"`
+	Date                      time.Time       `json:"-" gorm:"type:date"`
+	Updated                   time.Time       `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the filingDate and transactionDate fields, which are specified
+// as "YYYY-MM-DD", and the effectiveDate, date, and updated fields, which are specified in
+// milliseconds since the epoch, into time.Times.
+// It will return an error if the JSON cannot be unmarshaled, but NOT if the date parsing fails.
+func (i *InsiderTransaction) UnmarshalJSON(data []byte) (err error) {
+	type transaction InsiderTransaction
+	type embedded struct {
+		transaction
+		EffectiveDate   int64  `json:"effectiveDate,omitempty"`
+		FilingDate      string `json:"filingDate,omitempty"`
+		TransactionDate string `json:"transactionDate,omitempty"`
+		Date            int64  `json:"date,omitempty"`
+		Updated         int64  `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*i = InsiderTransaction(tmp.transaction)
+		// Ignore date parsing issues in case one or more dates are missing
+		i.FilingDate, _ = time.Parse("2006-01-02", tmp.FilingDate)           // nolint:errcheck
+		i.TransactionDate, _ = time.Parse("2006-01-02", tmp.TransactionDate) // nolint:errcheck
+		if tmp.EffectiveDate > 0 {
+			i.EffectiveDate = time.Unix(tmp.EffectiveDate/1000, tmp.EffectiveDate%1000*1e6) // nolint:gomnd
+		}
+		if tmp.Date > 0 {
+			i.Date = time.Unix(tmp.Date/1000, tmp.Date%1000*1e6) // nolint:gomnd
+		}
+		if tmp.Updated > 0 {
+			i.Updated = time.Unix(tmp.Updated/1000, tmp.Updated%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (i *InsiderTransaction) MarshalJSON() ([]byte, error) {
+	type transaction InsiderTransaction
+	type embedded struct {
+		transaction
+		EffectiveDate   int64  `json:"effectiveDate,omitempty"`
+		FilingDate      string `json:"filingDate,omitempty"`
+		TransactionDate string `json:"transactionDate,omitempty"`
+		Date            int64  `json:"date,omitempty"`
+		Updated         int64  `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.transaction = transaction(*i)
+	tmp.FilingDate = i.FilingDate.Format("2006-01-02")
+	tmp.TransactionDate = i.TransactionDate.Format("2006-01-02")
+	if !i.EffectiveDate.IsZero() {
+		tmp.EffectiveDate = i.EffectiveDate.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !i.Date.IsZero() {
+		tmp.Date = i.Date.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !i.Updated.IsZero() {
+		tmp.Updated = i.Updated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the FullName, TransactionCode or TransactionDate fields are
+// equal to their zero value, or if the TransactionCode is not a known code.
+func (i *InsiderTransaction) Validate() error {
+	switch {
+	case i.FullName == "":
+		return fmt.Errorf("full name is missing")
+	case i.TransactionCode == "":
+		return fmt.Errorf("transaction code is missing")
+	case !i.TransactionCode.IsValid():
+		return fmt.Errorf("unknown transaction code %q", i.TransactionCode)
+	case i.TransactionDate.IsZero():
+		return fmt.Errorf("transaction date is missing")
+	}
+	return nil
+}
+
+// InsiderActivity summarizes the open market purchases and sales of insiders in one symbol.
+// Shares and values are always positive; use NetShares and NetValue for the difference.
+type InsiderActivity struct {
+	Symbol       string
+	SharesBought float64
+	SharesSold   float64
+	ValueBought  float64
+	ValueSold    float64
+}
+
+// NetShares returns the number of shares bought less the number of shares sold.
+func (a InsiderActivity) NetShares() float64 {
+	return a.SharesBought - a.SharesSold
+}
+
+// NetValue returns the value of shares bought less the value of shares sold.
+func (a InsiderActivity) NetValue() float64 {
+	return a.ValueBought - a.ValueSold
+}
+
+// NetInsiderBuying aggregates open market purchases (P) and sales (S) by symbol for
+// transactions whose TransactionDate falls between from and to, inclusive.
+// Other transaction codes, such as awards and option exercises, are ignored because
+// they do not reflect a decision to buy or sell at the market price. If a transaction
+// does not report a value, the value is computed from its shares and price.
+func NetInsiderBuying(transactions []InsiderTransaction, from, to time.Time) map[string]InsiderActivity {
+	res := make(map[string]InsiderActivity)
+	for idx := range transactions {
+		t := &transactions[idx]
+		if t.TransactionDate.Before(from) || t.TransactionDate.After(to) || t.TransactionShares == nil {
+			continue
+		}
+		if t.TransactionCode != TransactionCodePurchase && t.TransactionCode != TransactionCodeSale {
+			continue
+		}
+
+		shares := math.Abs(*t.TransactionShares)
+		var value float64
+		switch {
+		case t.TransactionValue != nil:
+			value = math.Abs(*t.TransactionValue)
+		case t.TransactionPrice != nil:
+			value = shares * *t.TransactionPrice
+		}
+
+		activity := res[t.Symbol]
+		activity.Symbol = t.Symbol
+		if t.TransactionCode == TransactionCodePurchase {
+			activity.SharesBought += shares
+			activity.ValueBought += value
+		} else {
+			activity.SharesSold += shares
+			activity.ValueSold += value
+		}
+		res[t.Symbol] = activity
+	}
+	return res
+}
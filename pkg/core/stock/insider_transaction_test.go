@@ -0,0 +1,159 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("InsiderTransaction", func() {
+	var expected []InsiderTransaction
+	BeforeEach(func() {
+		expected = []InsiderTransaction{{
+			DirectIndirect:    "D",
+			EffectiveDate:     time.Date(2021, time.April, 6, 0, 0, 0, 0, time.UTC),
+			FilingDate:        time.Date(2021, time.April, 7, 0, 0, 0, 0, time.UTC),
+			FullName:          "Jeffrey Williams",
+			Is10b51:           true,
+			PostShares:        func(i float64) *float64 { return &i }(489944),
+			ReportedTitle:     "COO",
+			Symbol:            "AAPL",
+			TransactionCode:   TransactionCodeSale,
+			TransactionDate:   time.Date(2021, time.April, 5, 0, 0, 0, 0, time.UTC),
+			TransactionPrice:  func(i float64) *float64 { return &i }(125.19),
+			TransactionShares: func(i float64) *float64 { return &i }(-100000),
+			TransactionValue:  func(i float64) *float64 { return &i }(12519000),
+			ID:                "INSIDER_TRANSACTIONS",
+			Key:               "AAPL",
+			Subkey:            "0001496686",
+			Date:              time.Date(2021, time.April, 5, 0, 0, 0, 0, time.UTC),
+			Updated:           time.Date(2021, time.April, 8, 0, 0, 45, 0, time.UTC),
+		}, {
+			ConversionOrExercisePrice: func(i float64) *float64 { return &i }(24.06),
+			DirectIndirect:            "D",
+			EffectiveDate:             time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC),
+			FilingDate:                time.Date(2021, time.April, 2, 0, 0, 0, 0, time.UTC),
+			FullName:                  "Arthur Levinson",
+			PostShares:                func(i float64) *float64 { return &i }(4563536),
+			ReportedTitle:             "Director",
+			Symbol:                    "AAPL",
+			TransactionCode:           TransactionCodePurchase,
+			TransactionDate:           time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC),
+			TransactionPrice:          func(i float64) *float64 { return &i }(122.5),
+			TransactionShares:         func(i float64) *float64 { return &i }(2000),
+			ID:                        "INSIDER_TRANSACTIONS",
+			Key:                       "AAPL",
+			Subkey:                    "0001214128",
+			Date:                      time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC),
+			Updated:                   time.Date(2021, time.April, 3, 0, 0, 0, 0, time.UTC),
+		}}
+	})
+
+	It("should parse insider transactions correctly", func() {
+		var res []InsiderTransaction
+		helper.TestdataFromJSON("core/stock/insider_transactions.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenInsiderTransactions()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("insider_transaction", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the InsiderTransaction is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the FullName is empty", func() {
+			expected[0].FullName = ""
+			Expect(expected[0].Validate()).To(MatchError("full name is missing"))
+		})
+		It("should return an error if the TransactionCode is empty", func() {
+			expected[0].TransactionCode = ""
+			Expect(expected[0].Validate()).To(MatchError("transaction code is missing"))
+		})
+		It("should return an error if the TransactionCode is unknown", func() {
+			expected[0].TransactionCode = "N"
+			Expect(expected[0].Validate()).To(MatchError(`unknown transaction code "N"`))
+		})
+		It("should return an error if the TransactionDate is zero valued", func() {
+			expected[0].TransactionDate = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("transaction date is missing"))
+		})
+	})
+
+	Describe("NetInsiderBuying()", func() {
+		var from, to time.Time
+		BeforeEach(func() {
+			from = time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC)
+			to = time.Date(2021, time.April, 30, 0, 0, 0, 0, time.UTC)
+			expected = append(expected, InsiderTransaction{
+				Symbol:            "AAPL",
+				TransactionCode:   TransactionCodeAward,
+				TransactionDate:   time.Date(2021, time.April, 2, 0, 0, 0, 0, time.UTC),
+				TransactionShares: func(i float64) *float64 { return &i }(50000),
+			}, InsiderTransaction{
+				Symbol:            "MSFT",
+				TransactionCode:   TransactionCodePurchase,
+				TransactionDate:   time.Date(2021, time.March, 31, 0, 0, 0, 0, time.UTC),
+				TransactionShares: func(i float64) *float64 { return &i }(1000),
+			})
+		})
+
+		It("should net purchases against sales within the window", func() {
+			res := NetInsiderBuying(expected, from, to)
+			Expect(res).To(HaveLen(1))
+			Expect(res["AAPL"]).To(Equal(InsiderActivity{
+				Symbol:       "AAPL",
+				SharesBought: 2000,
+				SharesSold:   100000,
+				ValueBought:  245000,
+				ValueSold:    12519000,
+			}))
+			Expect(res["AAPL"].NetShares()).To(BeNumerically("==", -98000))
+			Expect(res["AAPL"].NetValue()).To(BeNumerically("==", -12274000))
+		})
+		It("should include transactions on the window boundaries", func() {
+			res := NetInsiderBuying(expected, from.AddDate(0, 0, -1), to)
+			Expect(res).To(HaveKey("MSFT"))
+			Expect(res["MSFT"].NetShares()).To(BeNumerically("==", 1000))
+		})
+	})
+
+	Describe("TransactionCode", func() {
+		It("should describe known codes", func() {
+			Expect(TransactionCodeExercise.Description()).To(Equal("exercise or conversion of derivative security"))
+			Expect(TransactionCode("N").Description()).To(BeEmpty())
+		})
+		It("should scan values from the database", func() {
+			var code TransactionCode
+			Expect(code.Scan([]byte("G"))).To(Succeed())
+			Expect(code).To(Equal(TransactionCodeGift))
+			Expect(code.Scan(1)).ToNot(Succeed())
+		})
+	})
+})
@@ -0,0 +1,117 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// News represents a data point from the News endpoint.
+// The same schema is returned by the news time series, which adds the
+// standard time series fields (id, key, subkey, date and updated).
+// https://iexcloud.io/docs/api/#news
+type News struct {
+	Datetime   time.Time `json:"-" gorm:"primaryKey"`
+	Headline   string    `json:"headline,omitempty" gorm:"primaryKey;type:character varying"`
+	Source     string    `json:"source,omitempty" gorm:"type:character varying"`
+	URL        string    `json:"url,omitempty" gorm:"type:character varying"`
+	Summary    string    `json:"summary,omitempty" gorm:"type:text"`
+	Related    []string  `json:"-" gorm:"-"`
+	Image      string    `json:"image,omitempty" gorm:"type:character varying"`
+	ImageURL   string    `json:"imageUrl,omitempty" gorm:"type:character varying"`
+	QMURL      string    `json:"qmUrl,omitempty" gorm:"type:character varying"`
+	Lang       string    `json:"lang,omitempty" gorm:"type:character varying"`
+	HasPaywall bool      `json:"hasPaywall"`
+	Provider   string    `json:"provider,omitempty" gorm:"type:character varying"`
+	ID         string    `json:"id,omitempty" gorm:"-"`
+	Key        string    `json:"key,omitempty" gorm:"-"`
+	Subkey     string    `json:"subkey,omitempty" gorm:"-"`
+	Date       time.Time `json:"-" gorm:"-"`
+	Updated    time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function translates the datetime, date, and updated fields, which are specified
+// in milliseconds since the epoch, into time.Times, and splits the comma-separated
+// related field into a slice of symbols.
+// It will return an error if the JSON cannot be unmarshaled.
+func (n *News) UnmarshalJSON(data []byte) (err error) {
+	type news News
+	type embedded struct {
+		news
+		Datetime int64  `json:"datetime,omitempty"`
+		Related  string `json:"related,omitempty"`
+		Date     int64  `json:"date,omitempty"`
+		Updated  int64  `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*n = News(tmp.news)
+		n.Datetime = time.Unix(tmp.Datetime/1000, tmp.Datetime%1000*1e6) // nolint:gomnd
+		if tmp.Related != "" {
+			n.Related = strings.Split(tmp.Related, ",")
+		}
+		if tmp.Date > 0 {
+			n.Date = time.Unix(tmp.Date/1000, tmp.Date%1000*1e6) // nolint:gomnd
+		}
+		if tmp.Updated > 0 {
+			n.Updated = time.Unix(tmp.Updated/1000, tmp.Updated%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (n *News) MarshalJSON() ([]byte, error) {
+	type news News
+	type embedded struct {
+		news
+		Datetime int64  `json:"datetime,omitempty"`
+		Related  string `json:"related,omitempty"`
+		Date     int64  `json:"date,omitempty"`
+		Updated  int64  `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.news = news(*n)
+	tmp.Datetime = n.Datetime.UnixNano() / 1e6 // nolint:gomnd
+	tmp.Related = strings.Join(n.Related, ",")
+	if !n.Date.IsZero() {
+		tmp.Date = n.Date.UnixNano() / 1e6 // nolint:gomnd
+	}
+	if !n.Updated.IsZero() {
+		tmp.Updated = n.Updated.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Datetime, Headline, or URL fields are equal to their zero value.
+func (n *News) Validate() error {
+	switch {
+	case n.Datetime.IsZero():
+		return fmt.Errorf("datetime is missing")
+	case n.Headline == "":
+		return fmt.Errorf("headline is missing")
+	case n.URL == "":
+		return fmt.Errorf("url is missing")
+	}
+	return nil
+}
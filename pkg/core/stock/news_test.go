@@ -0,0 +1,93 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("News", func() {
+	var expected []News
+	BeforeEach(func() {
+		expected = []News{{
+			Datetime:   time.Date(2021, time.June, 17, 20, 30, 0, 0, time.UTC),
+			Headline:   "Apple Unveils New Privacy Features Across Its Platforms",
+			Source:     "Business Wire",
+			URL:        "https://cloud.iexapis.com/v1/news/article/2b8ba3a0-2c5e-4b3a-9b6f-2a5c5f1d3e11",
+			Summary:    "Apple today previewed new privacy protections coming this fall.",
+			Related:    []string{"AAPL", "GOOGL", "MSFT"},
+			Image:      "https://cloud.iexapis.com/v1/news/image/2b8ba3a0-2c5e-4b3a-9b6f-2a5c5f1d3e11",
+			Lang:       "en",
+			HasPaywall: false,
+		}, {
+			Datetime:   time.Date(2021, time.June, 17, 19, 30, 0, 123*1e6, time.UTC),
+			Headline:   "Chip Shortage Weighs on Hardware Makers",
+			Source:     "Reuters",
+			URL:        "https://cloud.iexapis.com/v1/news/article/9f0c1e6a-8e2d-4c7b-a1d4-6e3b2f7a8c90",
+			Summary:    "Supply constraints continue to hit device shipments.",
+			Related:    []string{"AAPL"},
+			Image:      "https://cloud.iexapis.com/v1/news/image/9f0c1e6a-8e2d-4c7b-a1d4-6e3b2f7a8c90",
+			Lang:       "en",
+			HasPaywall: true,
+			Provider:   "CityFalcon",
+			ID:         "NEWS",
+			Key:        "AAPL",
+			Subkey:     "9f0c1e6a-8e2d-4c7b-a1d4-6e3b2f7a8c90",
+			Date:       time.Date(2021, time.June, 17, 19, 30, 0, 123*1e6, time.UTC),
+			Updated:    time.Date(2021, time.June, 17, 19, 42, 1, 0, time.UTC),
+		}}
+	})
+
+	It("should parse news correctly", func() {
+		var res []News
+		helper.TestdataFromJSON("core/stock/news.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenNews()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("news", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the News is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the Datetime is zero valued", func() {
+			expected[0].Datetime = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("datetime is missing"))
+		})
+		It("should return an error if the Headline is empty", func() {
+			expected[0].Headline = ""
+			Expect(expected[0].Validate()).To(MatchError("headline is missing"))
+		})
+		It("should return an error if the URL is empty", func() {
+			expected[0].URL = ""
+			Expect(expected[0].Validate()).To(MatchError("url is missing"))
+		})
+	})
+})
@@ -0,0 +1,122 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// OHLC represents the data returned by the OHLC endpoint: the official open and
+// close prints for a symbol along with the day's high and low.
+// The high, low, and volume are 15 minute delayed for non-IEX data.
+// https://iexcloud.io/docs/api/#ohlc
+type OHLC struct {
+	Symbol string        `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	Open   OfficialPrice `json:"open" gorm:"embedded;embeddedPrefix:open_"`
+	Close  OfficialPrice `json:"close" gorm:"embedded;embeddedPrefix:close_"`
+	High   float64       `json:"high,omitempty" gorm:"type:double precision"`
+	Low    float64       `json:"low,omitempty" gorm:"type:double precision"`
+	Volume float64       `json:"volume,omitempty" gorm:"type:double precision"`
+}
+
+// ToHistorical converts the OHLC into a Historical data point for the trading day of the
+// official prints. The Date is that day in America/New_York, represented as midnight UTC
+// like the Historical Prices endpoint, and Updated is the time of the latest print.
+// The Volume is only set if it is known.
+func (o *OHLC) ToHistorical() Historical {
+	h := Historical{
+		Symbol: o.Symbol,
+		Open:   o.Open.Price,
+		Close:  o.Close.Price,
+		High:   o.High,
+		Low:    o.Low,
+	}
+	if o.Volume > 0 {
+		volume := o.Volume
+		h.Volume = &volume
+	}
+
+	h.Updated = o.Close.Time
+	if o.Open.Time.After(h.Updated) {
+		h.Updated = o.Open.Time
+	}
+	if !h.Updated.IsZero() {
+		if easternTime, err := time.LoadLocation("America/New_York"); err == nil {
+			y, m, d := h.Updated.In(easternTime).Date()
+			h.Date = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		}
+	}
+	return h
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol is missing or the official open is missing.
+// The official close is not available until after the market closes.
+func (o *OHLC) Validate() error {
+	switch {
+	case o.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case o.Open.Time.IsZero():
+		return fmt.Errorf("open time is missing")
+	}
+	return nil
+}
+
+// OfficialPrice is an official open or close print and the time it was published.
+type OfficialPrice struct {
+	Price float64   `json:"price,omitempty" gorm:"type:double precision"`
+	Time  time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the time field, which is specified in milliseconds
+// since the epoch, into a time.Time.
+// It will return an error if the JSON cannot be unmarshaled.
+func (p *OfficialPrice) UnmarshalJSON(data []byte) (err error) {
+	type officialPrice OfficialPrice
+	type embedded struct {
+		officialPrice
+		Time int64 `json:"time,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*p = OfficialPrice(tmp.officialPrice)
+		if tmp.Time > 0 {
+			p.Time = time.Unix(tmp.Time/1000, tmp.Time%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+// It has a value receiver so the prices of OHLC values in a map are marshaled too.
+func (p OfficialPrice) MarshalJSON() ([]byte, error) {
+	type officialPrice OfficialPrice
+	type embedded struct {
+		officialPrice
+		Time int64 `json:"time,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.officialPrice = officialPrice(p)
+	if !p.Time.IsZero() {
+		tmp.Time = p.Time.UnixNano() / 1e6 // nolint:gomnd
+	}
+	return json.Marshal(tmp)
+}
@@ -0,0 +1,113 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("OHLC", func() {
+	var expected map[string]OHLC
+	BeforeEach(func() {
+		expected = map[string]OHLC{
+			"AAPL": {
+				Symbol: "AAPL",
+				Open:   OfficialPrice{Price: 142.77, Time: time.Date(2021, time.July, 8, 13, 30, 0, 535e6, time.UTC)},
+				Close:  OfficialPrice{Price: 143.24, Time: time.Date(2021, time.July, 8, 20, 0, 0, 412e6, time.UTC)},
+				High:   144.89,
+				Low:    142.66,
+				Volume: 105575458,
+			},
+			"SPY": {
+				Open:   OfficialPrice{Price: 428.78, Time: time.Date(2021, time.July, 8, 13, 30, 0, 281e6, time.UTC)},
+				Close:  OfficialPrice{Price: 430.92, Time: time.Date(2021, time.July, 8, 20, 0, 0, 74e6, time.UTC)},
+				High:   431.73,
+				Low:    427.52,
+				Volume: 97595225,
+			},
+		}
+	})
+
+	It("should parse OHLC correctly", func() {
+		var res map[string]OHLC
+		helper.TestdataFromJSON("core/stock/ohlc.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenOHLC()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("ohlc", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("ToHistorical()", func() {
+		It("should convert the official prints", func() {
+			o := expected["AAPL"]
+			volume := 105575458.0
+			Expect(o.ToHistorical()).To(Equal(Historical{
+				Symbol:  "AAPL",
+				Open:    142.77,
+				Close:   143.24,
+				High:    144.89,
+				Low:     142.66,
+				Volume:  &volume,
+				Date:    time.Date(2021, time.July, 8, 0, 0, 0, 0, time.UTC),
+				Updated: o.Close.Time,
+			}))
+		})
+		It("should use the trading day in New York", func() {
+			o := expected["AAPL"]
+			o.Close.Time = time.Date(2021, time.July, 9, 1, 0, 0, 0, time.UTC)
+			Expect(o.ToHistorical().Date).To(Equal(time.Date(2021, time.July, 8, 0, 0, 0, 0, time.UTC)))
+		})
+		It("should use the open before the close is published", func() {
+			o := expected["AAPL"]
+			o.Close = OfficialPrice{}
+			o.Volume = 0
+			h := o.ToHistorical()
+			Expect(h.Updated).To(Equal(o.Open.Time))
+			Expect(h.Date).To(Equal(time.Date(2021, time.July, 8, 0, 0, 0, 0, time.UTC)))
+			Expect(h.Volume).To(BeNil())
+		})
+	})
+
+	Describe("Validate()", func() {
+		var o OHLC
+		BeforeEach(func() { o = expected["AAPL"] })
+
+		It("should succeed if the OHLC is valid", func() {
+			Expect(o.Validate()).To(Succeed())
+		})
+		It("should return an error if the Symbol is missing", func() {
+			o.Symbol = ""
+			Expect(o.Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the open time is missing", func() {
+			o.Open.Time = time.Time{}
+			Expect(o.Validate()).To(MatchError("open time is missing"))
+		})
+	})
+})
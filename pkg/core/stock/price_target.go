@@ -0,0 +1,81 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PriceTarget represents the data returned by the Price Target endpoint.
+// https://iexcloud.io/docs/api/#price-target
+type PriceTarget struct {
+	Symbol             string    `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	UpdatedDate        time.Time `json:"-" gorm:"primaryKey;type:date"`
+	PriceTargetAverage float64   `json:"priceTargetAverage,omitempty" gorm:"type:double precision"`
+	PriceTargetHigh    float64   `json:"priceTargetHigh,omitempty" gorm:"type:double precision"`
+	PriceTargetLow     float64   `json:"priceTargetLow,omitempty" gorm:"type:double precision"`
+	NumberOfAnalysts   int       `json:"numberOfAnalysts,omitempty"`
+	Currency           string    `json:"currency,omitempty" gorm:"type:character varying"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the updatedDate field, which is specified as "YYYY-MM-DD",
+// into a time.Time by using time.Parse().
+// It will return an error if the JSON cannot be unmarshaled, but NOT if the date parsing fails.
+func (p *PriceTarget) UnmarshalJSON(data []byte) (err error) {
+	type target PriceTarget
+	type embedded struct {
+		target
+		UpdatedDate string `json:"updatedDate,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*p = PriceTarget(tmp.target)
+		p.UpdatedDate, _ = time.Parse("2006-01-02", tmp.UpdatedDate) // nolint:errcheck
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (p *PriceTarget) MarshalJSON() ([]byte, error) {
+	type target PriceTarget
+	type embedded struct {
+		target
+		UpdatedDate string `json:"updatedDate,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.target = target(*p)
+	tmp.UpdatedDate = p.UpdatedDate.Format("2006-01-02")
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol, UpdatedDate, or PriceTargetAverage fields are equal to their zero value.
+func (p *PriceTarget) Validate() error {
+	switch {
+	case p.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case p.UpdatedDate.IsZero():
+		return fmt.Errorf("updated date is missing")
+	case p.PriceTargetAverage == 0:
+		return fmt.Errorf("average price target is zero")
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("PriceTarget", func() {
+	var expected *PriceTarget
+	BeforeEach(func() {
+		expected = &PriceTarget{
+			Symbol:             "AAPL",
+			UpdatedDate:        time.Date(2021, time.July, 6, 0, 0, 0, 0, time.UTC),
+			PriceTargetAverage: 165.58,
+			PriceTargetHigh:    200,
+			PriceTargetLow:     83,
+			NumberOfAnalysts:   38,
+			Currency:           "USD",
+		}
+	})
+
+	It("should parse the price target correctly", func() {
+		var res *PriceTarget
+		helper.TestdataFromJSON("core/stock/price_target.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenPriceTarget()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("price_target", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the PriceTarget is valid", func() {
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should return an error if the Symbol is empty", func() {
+			expected.Symbol = ""
+			Expect(expected.Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the UpdatedDate is zero valued", func() {
+			expected.UpdatedDate = time.Time{}
+			Expect(expected.Validate()).To(MatchError("updated date is missing"))
+		})
+		It("should return an error if the PriceTargetAverage is zero", func() {
+			expected.PriceTargetAverage = 0
+			Expect(expected.Validate()).To(MatchError("average price target is zero"))
+		})
+	})
+})
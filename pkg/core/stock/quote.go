@@ -0,0 +1,181 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Quote represents a stock quote as IEX returns it. It is embedded in the response
+// from Stock.Book, and Market.List returns one for each symbol in a list.
+// Fields that IEX may return as null are pointers.
+// https://iexcloud.io/docs/api/#quote
+type Quote struct {
+	Symbol                 string    `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	CompanyName            string    `json:"companyName,omitempty" gorm:"type:character varying"`
+	PrimaryExchange        string    `json:"primaryExchange,omitempty" gorm:"type:character varying"`
+	CalculationPrice       string    `json:"calculationPrice,omitempty" gorm:"type:character varying"`
+	Open                   *float64  `json:"open,omitempty" gorm:"type:double precision"`
+	OpenTime               time.Time `json:"-"`
+	OpenSource             string    `json:"openSource,omitempty" gorm:"type:character varying"`
+	Close                  *float64  `json:"close,omitempty" gorm:"type:double precision"`
+	CloseTime              time.Time `json:"-"`
+	CloseSource            string    `json:"closeSource,omitempty" gorm:"type:character varying"`
+	High                   *float64  `json:"high,omitempty" gorm:"type:double precision"`
+	HighTime               time.Time `json:"-"`
+	HighSource             string    `json:"highSource,omitempty" gorm:"type:character varying"`
+	Low                    *float64  `json:"low,omitempty" gorm:"type:double precision"`
+	LowTime                time.Time `json:"-"`
+	LowSource              string    `json:"lowSource,omitempty" gorm:"type:character varying"`
+	LatestPrice            float64   `json:"latestPrice,omitempty" gorm:"type:double precision"`
+	LatestSource           string    `json:"latestSource,omitempty" gorm:"type:character varying"`
+	LatestTime             string    `json:"latestTime,omitempty" gorm:"type:character varying"`
+	LatestUpdate           time.Time `json:"-" gorm:"primaryKey"`
+	LatestVolume           *float64  `json:"latestVolume,omitempty" gorm:"type:double precision"`
+	IEXRealtimePrice       *float64  `json:"iexRealtimePrice,omitempty" gorm:"type:double precision"`
+	IEXRealtimeSize        *float64  `json:"iexRealtimeSize,omitempty" gorm:"type:double precision"`
+	IEXLastUpdated         time.Time `json:"-"`
+	DelayedPrice           *float64  `json:"delayedPrice,omitempty" gorm:"type:double precision"`
+	DelayedPriceTime       time.Time `json:"-"`
+	OddLotDelayedPrice     *float64  `json:"oddLotDelayedPrice,omitempty" gorm:"type:double precision"`
+	OddLotDelayedPriceTime time.Time `json:"-"`
+	ExtendedPrice          *float64  `json:"extendedPrice,omitempty" gorm:"type:double precision"`
+	ExtendedChange         *float64  `json:"extendedChange,omitempty" gorm:"type:double precision"`
+	ExtendedChangePercent  *float64  `json:"extendedChangePercent,omitempty" gorm:"type:double precision"`
+	ExtendedPriceTime      time.Time `json:"-"`
+	PreviousClose          float64   `json:"previousClose,omitempty" gorm:"type:double precision"`
+	PreviousVolume         float64   `json:"previousVolume,omitempty" gorm:"type:double precision"`
+	Change                 float64   `json:"change,omitempty" gorm:"type:double precision"`
+	ChangePercent          float64   `json:"changePercent,omitempty" gorm:"type:double precision"`
+	Volume                 *float64  `json:"volume,omitempty" gorm:"type:double precision"`
+	IEXMarketPercent       *float64  `json:"iexMarketPercent,omitempty" gorm:"type:double precision"`
+	IEXVolume              *float64  `json:"iexVolume,omitempty" gorm:"type:double precision"`
+	AvgTotalVolume         float64   `json:"avgTotalVolume,omitempty" gorm:"type:double precision"`
+	IEXBidPrice            *float64  `json:"iexBidPrice,omitempty" gorm:"type:double precision"`
+	IEXBidSize             *float64  `json:"iexBidSize,omitempty" gorm:"type:double precision"`
+	IEXAskPrice            *float64  `json:"iexAskPrice,omitempty" gorm:"type:double precision"`
+	IEXAskSize             *float64  `json:"iexAskSize,omitempty" gorm:"type:double precision"`
+	IEXOpen                *float64  `json:"iexOpen,omitempty" gorm:"type:double precision"`
+	IEXOpenTime            time.Time `json:"-"`
+	IEXClose               *float64  `json:"iexClose,omitempty" gorm:"type:double precision"`
+	IEXCloseTime           time.Time `json:"-"`
+	MarketCap              float64   `json:"marketCap,omitempty" gorm:"type:double precision"`
+	PERatio                *float64  `json:"peRatio,omitempty" gorm:"type:double precision"`
+	Week52High             float64   `json:"week52High,omitempty" gorm:"type:double precision"`
+	Week52Low              float64   `json:"week52Low,omitempty" gorm:"type:double precision"`
+	YTDChange              float64   `json:"ytdChange,omitempty" gorm:"type:double precision"`
+	LastTradeTime          time.Time `json:"-"`
+	Currency               string    `json:"currency,omitempty" gorm:"type:character varying"`
+	IsUSMarketOpen         bool      `json:"isUSMarketOpen"`
+}
+
+// quoteTimes is used by the JSON methods to translate the millisecond timestamps.
+type quoteTimes struct {
+	OpenTime               int64 `json:"openTime,omitempty"`
+	CloseTime              int64 `json:"closeTime,omitempty"`
+	HighTime               int64 `json:"highTime,omitempty"`
+	LowTime                int64 `json:"lowTime,omitempty"`
+	LatestUpdate           int64 `json:"latestUpdate,omitempty"`
+	IEXLastUpdated         int64 `json:"iexLastUpdated,omitempty"`
+	DelayedPriceTime       int64 `json:"delayedPriceTime,omitempty"`
+	OddLotDelayedPriceTime int64 `json:"oddLotDelayedPriceTime,omitempty"`
+	ExtendedPriceTime      int64 `json:"extendedPriceTime,omitempty"`
+	IEXOpenTime            int64 `json:"iexOpenTime,omitempty"`
+	IEXCloseTime           int64 `json:"iexCloseTime,omitempty"`
+	LastTradeTime          int64 `json:"lastTradeTime,omitempty"`
+}
+
+// fields pairs each millisecond timestamp with the corresponding Quote field.
+func (t *quoteTimes) fields(q *Quote) []struct {
+	ms *int64
+	t  *time.Time
+} {
+	return []struct {
+		ms *int64
+		t  *time.Time
+	}{
+		{&t.OpenTime, &q.OpenTime},
+		{&t.CloseTime, &q.CloseTime},
+		{&t.HighTime, &q.HighTime},
+		{&t.LowTime, &q.LowTime},
+		{&t.LatestUpdate, &q.LatestUpdate},
+		{&t.IEXLastUpdated, &q.IEXLastUpdated},
+		{&t.DelayedPriceTime, &q.DelayedPriceTime},
+		{&t.OddLotDelayedPriceTime, &q.OddLotDelayedPriceTime},
+		{&t.ExtendedPriceTime, &q.ExtendedPriceTime},
+		{&t.IEXOpenTime, &q.IEXOpenTime},
+		{&t.IEXCloseTime, &q.IEXCloseTime},
+		{&t.LastTradeTime, &q.LastTradeTime},
+	}
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the timestamp fields, which are specified in
+// milliseconds since the epoch, into time.Times. Null or zero timestamps are left as zero values.
+// It will return an error if the JSON cannot be unmarshaled.
+func (q *Quote) UnmarshalJSON(data []byte) (err error) {
+	type quote Quote
+	type embedded struct {
+		quote
+		quoteTimes
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err != nil {
+		return
+	}
+	*q = Quote(tmp.quote)
+	for _, f := range tmp.quoteTimes.fields(q) {
+		if *f.ms > 0 {
+			*f.t = time.Unix(*f.ms/1000, *f.ms%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (q *Quote) MarshalJSON() ([]byte, error) {
+	type quote Quote
+	type embedded struct {
+		quote
+		quoteTimes
+	}
+	tmp := new(embedded)
+	tmp.quote = quote(*q)
+	for _, f := range tmp.quoteTimes.fields(q) {
+		if !f.t.IsZero() {
+			*f.ms = f.t.UnixNano() / 1e6 // nolint:gomnd
+		}
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Symbol, LatestPrice, or LatestUpdate fields are equal to their zero value.
+func (q *Quote) Validate() error {
+	switch {
+	case q.Symbol == "":
+		return fmt.Errorf("symbol is missing")
+	case q.LatestPrice == 0:
+		return fmt.Errorf("latest price is zero")
+	case q.LatestUpdate.IsZero():
+		return fmt.Errorf("latest update is missing")
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("Quote", func() {
+	var expected *Quote
+	BeforeEach(func() {
+		f := func(i float64) *float64 { return &i }
+		expected = &Quote{
+			Symbol:                 "AAPL",
+			CompanyName:            "Apple Inc",
+			PrimaryExchange:        "NASDAQ/NGS (GLOBAL SELECT MARKET)",
+			CalculationPrice:       "close",
+			Open:                   f(143.75),
+			OpenTime:               time.Date(2021, time.July, 8, 13, 30, 0, 529*1e6, time.UTC),
+			OpenSource:             "official",
+			Close:                  f(143.24),
+			CloseTime:              time.Date(2021, time.July, 8, 20, 0, 0, 490*1e6, time.UTC),
+			CloseSource:            "official",
+			High:                   f(144.89),
+			HighTime:               time.Date(2021, time.July, 8, 19, 59, 59, 970*1e6, time.UTC),
+			HighSource:             "15 minute delayed price",
+			Low:                    f(142.66),
+			LowTime:                time.Date(2021, time.July, 8, 13, 50, 43, 87*1e6, time.UTC),
+			LowSource:              "15 minute delayed price",
+			LatestPrice:            143.24,
+			LatestSource:           "Close",
+			LatestTime:             "July 8, 2021",
+			LatestUpdate:           time.Date(2021, time.July, 8, 20, 0, 0, 490*1e6, time.UTC),
+			LatestVolume:           f(105575458),
+			DelayedPrice:           f(143.23),
+			DelayedPriceTime:       time.Date(2021, time.July, 8, 19, 59, 59, 970*1e6, time.UTC),
+			OddLotDelayedPrice:     f(143.25),
+			OddLotDelayedPriceTime: time.Date(2021, time.July, 8, 19, 59, 59, 982*1e6, time.UTC),
+			ExtendedPrice:          f(143.5),
+			ExtendedChange:         f(0.26),
+			ExtendedChangePercent:  f(0.00182),
+			ExtendedPriceTime:      time.Date(2021, time.July, 8, 23, 59, 57, 995*1e6, time.UTC),
+			PreviousClose:          144.57,
+			PreviousVolume:         104911589,
+			Change:                 -1.33,
+			ChangePercent:          -0.0092,
+			Volume:                 f(105575458),
+			AvgTotalVolume:         80325587,
+			IEXOpen:                f(143.31),
+			IEXOpenTime:            time.Date(2021, time.July, 8, 13, 30, 0, 316*1e6, time.UTC),
+			IEXClose:               f(143.27),
+			IEXCloseTime:           time.Date(2021, time.July, 8, 19, 59, 59, 968*1e6, time.UTC),
+			MarketCap:              2391221733120,
+			PERatio:                f(32.12),
+			Week52High:             145.65,
+			Week52Low:              89.15,
+			YTDChange:              0.0793,
+			LastTradeTime:          time.Date(2021, time.July, 8, 19, 59, 59, 970*1e6, time.UTC),
+			Currency:               "USD",
+		}
+	})
+
+	It("should parse the quote correctly", func() {
+		var res *Quote
+		helper.TestdataFromJSON("core/stock/quote.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenQuote()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("quote", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the Quote is valid", func() {
+			Expect(expected.Validate()).To(Succeed())
+		})
+		It("should return an error if the Symbol is empty", func() {
+			expected.Symbol = ""
+			Expect(expected.Validate()).To(MatchError("symbol is missing"))
+		})
+		It("should return an error if the LatestPrice is zero", func() {
+			expected.LatestPrice = 0
+			Expect(expected.Validate()).To(MatchError("latest price is zero"))
+		})
+		It("should return an error if the LatestUpdate is zero valued", func() {
+			expected.LatestUpdate = time.Time{}
+			Expect(expected.Validate()).To(MatchError("latest update is missing"))
+		})
+	})
+})
@@ -0,0 +1,185 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Rating is a normalized analyst recommendation.
+type Rating string
+
+// These are the possible values of a Rating, from most to least bullish.
+const (
+	RatingBuy         Rating = "buy"
+	RatingOverweight  Rating = "overweight"
+	RatingHold        Rating = "hold"
+	RatingUnderweight Rating = "underweight"
+	RatingSell        Rating = "sell"
+)
+
+// ratingScores maps each Rating onto the 1 (buy) to 3 (sell) scale used by RatingScaleMark.
+var ratingScores = map[Rating]float64{
+	RatingBuy:         1,
+	RatingOverweight:  1.5,
+	RatingHold:        2,
+	RatingUnderweight: 2.5,
+	RatingSell:        3,
+}
+
+// RecommendationTrend represents a data point from the Recommendation Trends endpoint.
+// https://iexcloud.io/docs/api/#analyst-recommendations
+type RecommendationTrend struct {
+	Symbol                      string    `json:"symbol,omitempty" gorm:"primaryKey;type:character varying"`
+	ConsensusStartDate          time.Time `json:"-" gorm:"primaryKey"`
+	ConsensusEndDate            time.Time `json:"-"`
+	CorporateActionsAppliedDate time.Time `json:"-"`
+	RatingBuy                   int       `json:"ratingBuy"`
+	RatingOverweight            int       `json:"ratingOverweight"`
+	RatingHold                  int       `json:"ratingHold"`
+	RatingUnderweight           int       `json:"ratingUnderweight"`
+	RatingSell                  int       `json:"ratingSell"`
+	RatingNone                  int       `json:"ratingNone"`
+	RatingScaleMark             float64   `json:"ratingScaleMark,omitempty" gorm:"type:double precision"`
+	ID                          string    `json:"id,omitempty" gorm:"-"`
+	Key                         string    `json:"key,omitempty" gorm:"-"`
+	Subkey                      string    `json:"subkey,omitempty" gorm:"-"`
+	Date                        time.Time `json:"-" gorm:"type:date"`
+	Updated                     time.Time `json:"-"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date fields, which are specified in milliseconds
+// since the epoch, into time.Times.
+// It will return an error if the JSON cannot be unmarshaled.
+func (r *RecommendationTrend) UnmarshalJSON(data []byte) (err error) {
+	type trend RecommendationTrend
+	type embedded struct {
+		trend
+		ConsensusStartDate          int64 `json:"consensusStartDate,omitempty"`
+		ConsensusEndDate            int64 `json:"consensusEndDate,omitempty"`
+		CorporateActionsAppliedDate int64 `json:"corporateActionsAppliedDate,omitempty"`
+		Date                        int64 `json:"date,omitempty"`
+		Updated                     int64 `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err != nil {
+		return
+	}
+	*r = RecommendationTrend(tmp.trend)
+	for _, f := range []struct {
+		dst *time.Time
+		src int64
+	}{
+		{&r.ConsensusStartDate, tmp.ConsensusStartDate},
+		{&r.ConsensusEndDate, tmp.ConsensusEndDate},
+		{&r.CorporateActionsAppliedDate, tmp.CorporateActionsAppliedDate},
+		{&r.Date, tmp.Date},
+		{&r.Updated, tmp.Updated},
+	} {
+		if f.src > 0 {
+			*f.dst = time.Unix(f.src/1000, f.src%1000*1e6) // nolint:gomnd
+		}
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (r *RecommendationTrend) MarshalJSON() ([]byte, error) {
+	type trend RecommendationTrend
+	type embedded struct {
+		trend
+		ConsensusStartDate          int64 `json:"consensusStartDate,omitempty"`
+		ConsensusEndDate            int64 `json:"consensusEndDate,omitempty"`
+		CorporateActionsAppliedDate int64 `json:"corporateActionsAppliedDate,omitempty"`
+		Date                        int64 `json:"date,omitempty"`
+		Updated                     int64 `json:"updated,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.trend = trend(*r)
+	for _, f := range []struct {
+		dst *int64
+		src time.Time
+	}{
+		{&tmp.ConsensusStartDate, r.ConsensusStartDate},
+		{&tmp.ConsensusEndDate, r.ConsensusEndDate},
+		{&tmp.CorporateActionsAppliedDate, r.CorporateActionsAppliedDate},
+		{&tmp.Date, r.Date},
+		{&tmp.Updated, r.Updated},
+	} {
+		if !f.src.IsZero() {
+			*f.dst = f.src.UnixNano() / 1e6 // nolint:gomnd
+		}
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the ConsensusStartDate is equal to its zero value or if there are no ratings.
+func (r *RecommendationTrend) Validate() error {
+	switch {
+	case r.ConsensusStartDate.IsZero():
+		return fmt.Errorf("consensus start date is missing")
+	case r.Total() == 0:
+		return fmt.Errorf("no ratings")
+	}
+	return nil
+}
+
+// Total returns the number of analysts who issued a rating, excluding those with no rating.
+func (r *RecommendationTrend) Total() int {
+	return r.RatingBuy + r.RatingOverweight + r.RatingHold + r.RatingUnderweight + r.RatingSell
+}
+
+// ConsensusScore returns the average rating on the same 1 (buy) to 3 (sell) scale as
+// RatingScaleMark, with overweight and underweight ratings counting as 1.5 and 2.5.
+// The second return value is false if there are no ratings to average.
+func (r *RecommendationTrend) ConsensusScore() (float64, bool) {
+	total := r.Total()
+	if total == 0 {
+		return 0, false
+	}
+	sum := float64(r.RatingBuy)*ratingScores[RatingBuy] +
+		float64(r.RatingOverweight)*ratingScores[RatingOverweight] +
+		float64(r.RatingHold)*ratingScores[RatingHold] +
+		float64(r.RatingUnderweight)*ratingScores[RatingUnderweight] +
+		float64(r.RatingSell)*ratingScores[RatingSell]
+	return sum / float64(total), true
+}
+
+// Consensus returns the Rating closest to the ConsensusScore, or an empty Rating if there are no ratings.
+// Scores exactly between two ratings round toward hold.
+func (r *RecommendationTrend) Consensus() Rating {
+	score, ok := r.ConsensusScore()
+	if !ok {
+		return ""
+	}
+	switch {
+	case score < 1.25: // nolint:gomnd
+		return RatingBuy
+	case score < 1.75: // nolint:gomnd
+		return RatingOverweight
+	case score <= 2.25: // nolint:gomnd
+		return RatingHold
+	case score <= 2.75: // nolint:gomnd
+		return RatingUnderweight
+	}
+	return RatingSell
+}
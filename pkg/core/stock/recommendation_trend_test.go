@@ -0,0 +1,100 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("RecommendationTrend", func() {
+	var expected []RecommendationTrend
+	BeforeEach(func() {
+		expected = []RecommendationTrend{{
+			ConsensusEndDate:            time.Date(2021, time.July, 6, 0, 0, 0, 0, time.UTC),
+			ConsensusStartDate:          time.Date(2021, time.June, 29, 0, 0, 0, 0, time.UTC),
+			CorporateActionsAppliedDate: time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+			RatingBuy:                   22,
+			RatingHold:                  9,
+			RatingNone:                  2,
+			RatingOverweight:            6,
+			RatingScaleMark:             1.36,
+			RatingSell:                  1,
+			RatingUnderweight:           2,
+			Symbol:                      "AAPL",
+			ID:                          "RECOMMENDATION_TRENDS",
+			Key:                         "AAPL",
+			Subkey:                      "NONE",
+			Date:                        time.Date(2021, time.July, 6, 0, 0, 0, 0, time.UTC),
+			Updated:                     time.Date(2021, time.July, 6, 13, 15, 46, 0, time.UTC),
+		}}
+	})
+
+	It("should parse recommendation trends correctly", func() {
+		var res []RecommendationTrend
+		helper.TestdataFromJSON("core/stock/recommendation_trends.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenRecommendationTrends()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("recommendation_trend", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the RecommendationTrend is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the ConsensusStartDate is zero valued", func() {
+			expected[0].ConsensusStartDate = time.Time{}
+			Expect(expected[0].Validate()).To(MatchError("consensus start date is missing"))
+		})
+		It("should return an error if there are no ratings", func() {
+			expected[0] = RecommendationTrend{ConsensusStartDate: expected[0].ConsensusStartDate, RatingNone: 3}
+			Expect(expected[0].Validate()).To(MatchError("no ratings"))
+		})
+	})
+
+	Describe("ConsensusScore()", func() {
+		It("should average the ratings on the rating scale mark scale", func() {
+			score, ok := expected[0].ConsensusScore()
+			Expect(ok).To(BeTrue())
+			Expect(score).To(BeNumerically("~", (22+6*1.5+9*2+2*2.5+1*3)/40.0, 1e-9))
+			Expect(expected[0].Consensus()).To(Equal(RatingOverweight))
+		})
+		It("should report when there is nothing to average", func() {
+			_, ok := (&RecommendationTrend{RatingNone: 1}).ConsensusScore()
+			Expect(ok).To(BeFalse())
+			Expect((&RecommendationTrend{}).Consensus()).To(BeEmpty())
+		})
+		It("should map scores onto the nearest rating", func() {
+			Expect((&RecommendationTrend{RatingBuy: 1}).Consensus()).To(Equal(RatingBuy))
+			Expect((&RecommendationTrend{RatingBuy: 1, RatingSell: 1}).Consensus()).To(Equal(RatingHold))
+			Expect((&RecommendationTrend{RatingHold: 1, RatingSell: 1}).Consensus()).To(Equal(RatingUnderweight))
+			Expect((&RecommendationTrend{RatingSell: 4}).Consensus()).To(Equal(RatingSell))
+		})
+	})
+})
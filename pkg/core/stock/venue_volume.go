@@ -0,0 +1,79 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// VenueVolume represents a data point from the Volume by Venue endpoint.
+// The date is the last date the venue traded the symbol.
+// https://iexcloud.io/docs/api/#volume-by-venue
+type VenueVolume struct {
+	Volume           float64   `json:"volume"`
+	Venue            string    `json:"venue,omitempty" gorm:"primaryKey;type:character varying"`
+	VenueName        string    `json:"venueName,omitempty" gorm:"type:character varying"`
+	Date             time.Time `json:"-" gorm:"primaryKey;type:date"`
+	MarketPercent    float64   `json:"marketPercent" gorm:"type:double precision"`
+	AvgMarketPercent float64   `json:"avgMarketPercent" gorm:"type:double precision"`
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+// This function correctly translates the date field, which is specified as "YYYY-MM-DD",
+// into a time.Time by using time.Parse().
+// It will return an error if the JSON cannot be unmarshaled, but NOT if the date parsing fails.
+func (v *VenueVolume) UnmarshalJSON(data []byte) (err error) {
+	type volume VenueVolume
+	type embedded struct {
+		volume
+		Date string `json:"date,omitempty"`
+	}
+	tmp := new(embedded)
+	if err = json.Unmarshal(data, tmp); err == nil {
+		*v = VenueVolume(tmp.volume)
+		// The date is null for venues that have not traded the symbol
+		v.Date, _ = time.Parse("2006-01-02", tmp.Date) // nolint:errcheck
+	}
+	return
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+// It undoes what UnmarshalJSON does.
+func (v *VenueVolume) MarshalJSON() ([]byte, error) {
+	type volume VenueVolume
+	type embedded struct {
+		volume
+		Date string `json:"date,omitempty"`
+	}
+	tmp := new(embedded)
+	tmp.volume = volume(*v)
+	if !v.Date.IsZero() {
+		tmp.Date = v.Date.Format("2006-01-02")
+	}
+	return json.Marshal(tmp)
+}
+
+// Validate satisfies the Validator interface.
+// It will return an error if the Venue is missing.
+func (v *VenueVolume) Validate() error {
+	if v.Venue == "" {
+		return fmt.Errorf("venue is missing")
+	}
+	return nil
+}
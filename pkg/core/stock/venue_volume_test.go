@@ -0,0 +1,69 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package stock_test
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+var _ = Describe("VenueVolume", func() {
+	var expected []VenueVolume
+	BeforeEach(func() {
+		expected = []VenueVolume{{
+			Venue:     "XNYS",
+			VenueName: "NYSE",
+		}, {
+			Volume:           21655931,
+			Venue:            "XNAS",
+			VenueName:        "NASDAQ",
+			Date:             time.Date(2021, time.July, 8, 0, 0, 0, 0, time.UTC),
+			MarketPercent:    0.2051,
+			AvgMarketPercent: 0.1953,
+		}}
+	})
+
+	It("should parse volume by venue correctly", func() {
+		var res []VenueVolume
+		helper.TestdataFromJSON("core/stock/venue_volume.json", &res)
+		Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+	})
+
+	It("should match the golden file", func() {
+		golden := GoldenVenueVolume()
+		if !cmp.Equal(golden, expected) {
+			helper.ToGolden("venue_volume", expected)
+			Fail(cmp.Diff(golden, expected))
+		}
+	})
+
+	Describe("Validate()", func() {
+		It("should succeed if the VenueVolume is valid", func() {
+			Expect(expected[0].Validate()).To(Succeed())
+		})
+		It("should return an error if the Venue is empty", func() {
+			expected[0].Venue = ""
+			Expect(expected[0].Validate()).To(MatchError("venue is missing"))
+		})
+	})
+})
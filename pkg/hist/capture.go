@@ -0,0 +1,324 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package hist
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// These are the link types found in IEX HIST files and captures made from them.
+// http://www.tcpdump.org/linktypes.html
+const (
+	linkTypeEthernet = 1
+	linkTypeRaw      = 101
+	linkTypeLinuxSLL = 113
+	linkTypeIPv4     = 228
+)
+
+// maxPacketLength bounds the packets we accept, so a corrupt length cannot exhaust memory.
+const maxPacketLength = 1 << 18
+
+// errSkip is returned by blockReader.next for pcapng blocks that do not contain packets.
+var errSkip = errors.New("skip block")
+
+// packetReader reads the packets of a capture file. The returned data is only valid
+// until the next call.
+type packetReader interface {
+	next() (data []byte, linkType uint16, ts time.Time, err error)
+}
+
+// newPacketReader detects the format of the capture file from its magic number.
+func newPacketReader(r *bufio.Reader) (packetReader, error) {
+	magic, err := r.Peek(4)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the capture header: %w", err)
+	}
+	switch {
+	case binary.LittleEndian.Uint32(magic) == 0x0A0D0D0A:
+		return &pcapngReader{r: r}, nil
+	case binary.LittleEndian.Uint32(magic) == 0xA1B2C3D4:
+		return newPcapReader(r, binary.LittleEndian, false)
+	case binary.BigEndian.Uint32(magic) == 0xA1B2C3D4:
+		return newPcapReader(r, binary.BigEndian, false)
+	case binary.LittleEndian.Uint32(magic) == 0xA1B23C4D:
+		return newPcapReader(r, binary.LittleEndian, true)
+	case binary.BigEndian.Uint32(magic) == 0xA1B23C4D:
+		return newPcapReader(r, binary.BigEndian, true)
+	}
+	return nil, fmt.Errorf("unknown capture format: magic number %x", magic)
+}
+
+// pcapReader reads the classic libpcap format.
+// https://wiki.wireshark.org/Development/LibpcapFileFormat
+type pcapReader struct {
+	r        *bufio.Reader
+	order    binary.ByteOrder
+	nano     bool
+	linkType uint16
+	header   [16]byte
+	buf      []byte
+}
+
+func newPcapReader(r *bufio.Reader, order binary.ByteOrder, nano bool) (*pcapReader, error) {
+	var header [24]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, fmt.Errorf("could not read the pcap header: %w", err)
+	}
+	return &pcapReader{
+		r:        r,
+		order:    order,
+		nano:     nano,
+		linkType: uint16(order.Uint32(header[20:])),
+	}, nil
+}
+
+func (p *pcapReader) next() ([]byte, uint16, time.Time, error) {
+	if _, err := io.ReadFull(p.r, p.header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = fmt.Errorf("truncated pcap record header: %w", err)
+		}
+		return nil, 0, time.Time{}, err
+	}
+	sec, frac := int64(p.order.Uint32(p.header[0:])), int64(p.order.Uint32(p.header[4:]))
+	if !p.nano {
+		frac *= 1000
+	}
+	length := p.order.Uint32(p.header[8:])
+	data, err := readPacket(p.r, &p.buf, length)
+	return data, p.linkType, time.Unix(sec, frac), err
+}
+
+// pcapngReader reads the pcapng format, which IEX uses for its HIST files.
+// https://pcapng.github.io/pcapng/
+type pcapngReader struct {
+	r          *bufio.Reader
+	order      binary.ByteOrder
+	interfaces []pcapngInterface
+	header     [8]byte
+	buf        []byte
+}
+
+// pcapngInterface holds the parts of an interface description block we need.
+type pcapngInterface struct {
+	linkType uint16
+	// unitsPerSecond is the timestamp resolution, which defaults to microseconds.
+	unitsPerSecond int64
+}
+
+func (p *pcapngReader) next() (data []byte, linkType uint16, ts time.Time, err error) {
+	for {
+		data, linkType, ts, err = p.block()
+		if err != errSkip {
+			return
+		}
+	}
+}
+
+// block reads one block, returning errSkip if it does not contain a packet.
+func (p *pcapngReader) block() ([]byte, uint16, time.Time, error) {
+	if _, err := io.ReadFull(p.r, p.header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = fmt.Errorf("truncated pcapng block header: %w", err)
+		}
+		return nil, 0, time.Time{}, err
+	}
+
+	if binary.LittleEndian.Uint32(p.header[:]) == 0x0A0D0D0A {
+		return nil, 0, time.Time{}, p.sectionHeader()
+	}
+	if p.order == nil {
+		return nil, 0, time.Time{}, fmt.Errorf("pcapng file does not start with a section header")
+	}
+
+	blockType, length := p.order.Uint32(p.header[:]), p.order.Uint32(p.header[4:])
+	if length < 12 || length%4 != 0 {
+		return nil, 0, time.Time{}, fmt.Errorf("invalid pcapng block length %d", length)
+	}
+	body, err := readPacket(p.r, &p.buf, length-8)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+	body = body[:len(body)-4] // the trailing copy of the length
+
+	switch blockType {
+	case 1: // interface description block
+		if len(body) < 8 {
+			return nil, 0, time.Time{}, fmt.Errorf("invalid pcapng interface description block")
+		}
+		iface := pcapngInterface{linkType: p.order.Uint16(body)}
+		if iface.unitsPerSecond, err = p.resolution(body[8:], 1e6); err != nil {
+			return nil, 0, time.Time{}, err
+		}
+		p.interfaces = append(p.interfaces, iface)
+	case 3: // simple packet block
+		if len(p.interfaces) == 0 || len(body) < 4 {
+			return nil, 0, time.Time{}, fmt.Errorf("invalid pcapng simple packet block")
+		}
+		captured := p.order.Uint32(body)
+		if int(captured) > len(body)-4 {
+			captured = uint32(len(body) - 4)
+		}
+		return body[4 : 4+captured], p.interfaces[0].linkType, time.Time{}, nil
+	case 6: // enhanced packet block
+		if len(body) < 20 {
+			return nil, 0, time.Time{}, fmt.Errorf("invalid pcapng enhanced packet block")
+		}
+		id := p.order.Uint32(body)
+		if int(id) >= len(p.interfaces) {
+			return nil, 0, time.Time{}, fmt.Errorf("pcapng packet refers to unknown interface %d", id)
+		}
+		iface := p.interfaces[id]
+		units := int64(p.order.Uint32(body[4:]))<<32 | int64(p.order.Uint32(body[8:]))
+		captured := p.order.Uint32(body[12:])
+		if int(captured) > len(body)-20 {
+			return nil, 0, time.Time{}, fmt.Errorf("invalid pcapng enhanced packet length %d", captured)
+		}
+		ts := time.Unix(units/iface.unitsPerSecond, units%iface.unitsPerSecond*1e9/iface.unitsPerSecond)
+		return body[20 : 20+captured], iface.linkType, ts, nil
+	}
+	return nil, 0, time.Time{}, errSkip
+}
+
+// sectionHeader reads the rest of a section header block, which sets the byte order
+// and resets the interfaces.
+func (p *pcapngReader) sectionHeader() error {
+	var magic [4]byte
+	if _, err := io.ReadFull(p.r, magic[:]); err != nil {
+		return fmt.Errorf("truncated pcapng section header: %w", err)
+	}
+	switch {
+	case binary.LittleEndian.Uint32(magic[:]) == 0x1A2B3C4D:
+		p.order = binary.LittleEndian
+	case binary.BigEndian.Uint32(magic[:]) == 0x1A2B3C4D:
+		p.order = binary.BigEndian
+	default:
+		return fmt.Errorf("invalid pcapng byte order magic %x", magic)
+	}
+	length := p.order.Uint32(p.header[4:])
+	if length < 16 || length%4 != 0 {
+		return fmt.Errorf("invalid pcapng section header length %d", length)
+	}
+	p.interfaces = p.interfaces[:0]
+	if _, err := p.r.Discard(int(length) - 12); err != nil {
+		return fmt.Errorf("truncated pcapng section header: %w", err)
+	}
+	return errSkip
+}
+
+// resolution returns the timestamp resolution, in units per second, from the if_tsresol
+// option of an interface description block, or def if it is not present. Resolutions
+// finer than a nanosecond are rejected.
+func (p *pcapngReader) resolution(options []byte, def int64) (int64, error) {
+	for len(options) >= 4 {
+		code, length := p.order.Uint16(options), int(p.order.Uint16(options[2:]))
+		if code == 0 || len(options) < 4+length {
+			break
+		}
+		if code == 9 && length >= 1 { // if_tsresol
+			v, units := options[4], int64(1)
+			if v&0x80 != 0 {
+				if v&0x7f > 29 { // 2^30 exceeds 1e9
+					return 0, fmt.Errorf("unsupported pcapng timestamp resolution %#x", v)
+				}
+				return 1 << (v & 0x7f), nil
+			}
+			if v > 9 {
+				return 0, fmt.Errorf("unsupported pcapng timestamp resolution %#x", v)
+			}
+			for i := byte(0); i < v; i++ {
+				units *= 10
+			}
+			return units, nil
+		}
+		options = options[4+(length+3)/4*4:]
+	}
+	return def, nil
+}
+
+// readPacket reads length bytes into buf, growing it as needed.
+func readPacket(r io.Reader, buf *[]byte, length uint32) ([]byte, error) {
+	if length > maxPacketLength {
+		return nil, fmt.Errorf("packet length %d exceeds the maximum of %d", length, maxPacketLength)
+	}
+	if cap(*buf) < int(length) {
+		*buf = make([]byte, length)
+	}
+	data := (*buf)[:length]
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("truncated packet: %w", err)
+	}
+	return data, nil
+}
+
+// udpPayload returns the payload of a UDP over IPv4 packet, and false for any other packet.
+func udpPayload(data []byte, linkType uint16) ([]byte, bool) {
+	switch linkType {
+	case linkTypeEthernet:
+		if len(data) < 14 {
+			return nil, false
+		}
+		etherType := binary.BigEndian.Uint16(data[12:])
+		data = data[14:]
+		for etherType == 0x8100 || etherType == 0x88A8 { // VLAN tags
+			if len(data) < 4 {
+				return nil, false
+			}
+			etherType = binary.BigEndian.Uint16(data[2:])
+			data = data[4:]
+		}
+		if etherType != 0x0800 {
+			return nil, false
+		}
+	case linkTypeLinuxSLL:
+		if len(data) < 16 || binary.BigEndian.Uint16(data[14:]) != 0x0800 {
+			return nil, false
+		}
+		data = data[16:]
+	case linkTypeRaw, linkTypeIPv4:
+	default:
+		return nil, false
+	}
+
+	// IPv4
+	if len(data) < 20 || data[0]>>4 != 4 {
+		return nil, false
+	}
+	headerLength := int(data[0]&0x0f) * 4
+	totalLength := int(binary.BigEndian.Uint16(data[2:]))
+	if data[9] != 17 || headerLength < 20 || totalLength < headerLength || len(data) < totalLength {
+		return nil, false
+	}
+	if binary.BigEndian.Uint16(data[6:])&0x3fff != 0 { // fragmented
+		return nil, false
+	}
+	data = data[headerLength:totalLength]
+
+	// UDP
+	if len(data) < 8 {
+		return nil, false
+	}
+	udpLength := int(binary.BigEndian.Uint16(data[4:]))
+	if udpLength < 8 || udpLength > len(data) {
+		return nil, false
+	}
+	return data[8:udpLength], true
+}
@@ -0,0 +1,466 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package hist
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/onwsk8r/goiex/pkg/core/iex"
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+)
+
+// MessageType is the first byte of an IEX-TP message, which identifies its layout.
+type MessageType byte
+
+const (
+	MessageSystemEvent          MessageType = 'S'
+	MessageSecurityDirectory    MessageType = 'D'
+	MessageTradingStatus        MessageType = 'H'
+	MessageOpHaltStatus         MessageType = 'O'
+	MessageSSRStatus            MessageType = 'P'
+	MessageSecurityEvent        MessageType = 'E'
+	MessageQuoteUpdate          MessageType = 'Q'
+	MessageTradeReport          MessageType = 'T'
+	MessageTradeBreak           MessageType = 'B'
+	MessageOfficialPrice        MessageType = 'X'
+	MessageAuctionInformation   MessageType = 'A'
+	MessagePriceLevelUpdateBuy  MessageType = '8'
+	MessagePriceLevelUpdateSell MessageType = '5'
+)
+
+// Message is a decoded IEX-TP message. Use a type switch to get at the fields.
+type Message interface {
+	Type() MessageType
+}
+
+// priceScale is the number of price units in a dollar. IEX-TP prices are fixed point.
+const priceScale = 10000
+
+// These are the lengths of the fixed layout messages, including the type byte.
+const (
+	systemEventLength        = 10
+	securityDirectoryLength  = 31
+	tradingStatusLength      = 22
+	opHaltStatusLength       = 18
+	ssrStatusLength          = 19
+	securityEventLength      = 18
+	quoteUpdateLength        = 42
+	tradeReportLength        = 38
+	officialPriceLength      = 26
+	auctionInformationLength = 80
+	priceLevelUpdateLength   = 30
+)
+
+// SystemEvent marks a point in the trading day for the whole exchange.
+type SystemEvent struct {
+	Event     iex.SystemEventCode
+	Timestamp time.Time
+}
+
+// Type satisfies the Message interface.
+func (m *SystemEvent) Type() MessageType { return MessageSystemEvent }
+
+// SecurityDirectory describes a security traded on IEX. IEX sends one for each
+// security at the start of the day.
+type SecurityDirectory struct {
+	Flags        byte
+	Timestamp    time.Time
+	Symbol       string
+	RoundLotSize uint32
+	// AdjustedPOCPrice is the corporate action adjusted previous official closing price.
+	AdjustedPOCPrice float64
+	LULDTier         uint8
+}
+
+// Type satisfies the Message interface.
+func (m *SecurityDirectory) Type() MessageType { return MessageSecurityDirectory }
+
+// IsTestSecurity returns true if the security is a test security.
+func (m *SecurityDirectory) IsTestSecurity() bool { return m.Flags&0x80 != 0 }
+
+// IsWhenIssued returns true if the security is a when issued security.
+func (m *SecurityDirectory) IsWhenIssued() bool { return m.Flags&0x40 != 0 }
+
+// IsETP returns true if the security is an exchange traded product.
+func (m *SecurityDirectory) IsETP() bool { return m.Flags&0x20 != 0 }
+
+// TradingStatus is the trading status of a security on IEX.
+type TradingStatus struct {
+	Status    iex.TradingStatusCode
+	Timestamp time.Time
+	Symbol    string
+	Reason    iex.ReasonCode
+}
+
+// Type satisfies the Message interface.
+func (m *TradingStatus) Type() MessageType { return MessageTradingStatus }
+
+// OpHaltStatus is the operational halt status of a security on IEX.
+type OpHaltStatus struct {
+	IsHalted  bool
+	Timestamp time.Time
+	Symbol    string
+}
+
+// Type satisfies the Message interface.
+func (m *OpHaltStatus) Type() MessageType { return MessageOpHaltStatus }
+
+// SSRStatus is the short sale price test restriction status of a security.
+type SSRStatus struct {
+	IsSSR     bool
+	Timestamp time.Time
+	Symbol    string
+	Detail    iex.SSRDetail
+}
+
+// Type satisfies the Message interface.
+func (m *SSRStatus) Type() MessageType { return MessageSSRStatus }
+
+// SecurityEvent marks the open or close of a security on IEX. It is only sent on DEEP.
+type SecurityEvent struct {
+	Event     iex.SecurityEventType
+	Timestamp time.Time
+	Symbol    string
+}
+
+// Type satisfies the Message interface.
+func (m *SecurityEvent) Type() MessageType { return MessageSecurityEvent }
+
+// QuoteUpdate is a change to the IEX top of book for a security. It is only sent on TOPS.
+type QuoteUpdate struct {
+	Flags     byte
+	Timestamp time.Time
+	Symbol    string
+	BidSize   uint32
+	BidPrice  float64
+	AskPrice  float64
+	AskSize   uint32
+}
+
+// Type satisfies the Message interface.
+func (m *QuoteUpdate) Type() MessageType { return MessageQuoteUpdate }
+
+// IsActive returns false if the security is halted, paused or otherwise unavailable.
+func (m *QuoteUpdate) IsActive() bool { return m.Flags&0x80 == 0 }
+
+// IsRegularHours returns false if the quote was sent in the pre or post market session.
+func (m *QuoteUpdate) IsRegularHours() bool { return m.Flags&0x40 == 0 }
+
+// TradeReport is an execution on IEX.
+type TradeReport struct {
+	Flags     byte
+	Timestamp time.Time
+	Symbol    string
+	Size      uint32
+	Price     float64
+	TradeID   int64
+}
+
+// Type satisfies the Message interface.
+func (m *TradeReport) Type() MessageType { return MessageTradeReport }
+
+// ToTrade converts the TradeReport into a stock.Trade, as returned by the Book endpoint.
+func (m *TradeReport) ToTrade() stock.Trade {
+	return stock.Trade{
+		Price:                 m.Price,
+		Size:                  float64(m.Size),
+		TradeID:               m.TradeID,
+		IsISO:                 m.Flags&0x80 != 0,
+		IsOutsideRegularHours: m.Flags&0x40 != 0,
+		IsOddLot:              m.Flags&0x20 != 0,
+		IsTradeThroughExempt:  m.Flags&0x10 != 0,
+		IsSinglePriceCross:    m.Flags&0x08 != 0,
+		Timestamp:             m.Timestamp,
+	}
+}
+
+// TradeBreak cancels an earlier TradeReport with the same TradeID.
+type TradeBreak struct {
+	TradeReport
+}
+
+// Type satisfies the Message interface.
+func (m *TradeBreak) Type() MessageType { return MessageTradeBreak }
+
+// OfficialPrice is the official IEX opening or closing price of a security.
+type OfficialPrice struct {
+	PriceType iex.PriceType
+	Timestamp time.Time
+	Symbol    string
+	Price     float64
+}
+
+// Type satisfies the Message interface.
+func (m *OfficialPrice) Type() MessageType { return MessageOfficialPrice }
+
+// AuctionInformation describes an IEX auction in progress.
+type AuctionInformation struct {
+	AuctionType              iex.AuctionType
+	Timestamp                time.Time
+	Symbol                   string
+	PairedShares             uint32
+	ReferencePrice           float64
+	IndicativeClearingPrice  float64
+	ImbalanceShares          uint32
+	ImbalanceSide            iex.ImbalanceSide
+	ExtensionNumber          uint8
+	ScheduledAuctionTime     time.Time
+	AuctionBookClearingPrice float64
+	CollarReferencePrice     float64
+	LowerAuctionCollar       float64
+	UpperAuctionCollar       float64
+}
+
+// Type satisfies the Message interface.
+func (m *AuctionInformation) Type() MessageType { return MessageAuctionInformation }
+
+// Side is the side of the book a PriceLevelUpdate applies to.
+type Side byte
+
+const (
+	SideBuy  Side = '8'
+	SideSell Side = '5'
+)
+
+// PriceLevelUpdate is the new aggregate size at a price level of the IEX book.
+// A Size of zero removes the level. It is only sent on DEEP.
+type PriceLevelUpdate struct {
+	Side      Side
+	Flags     byte
+	Timestamp time.Time
+	Symbol    string
+	Size      uint32
+	Price     float64
+}
+
+// Type satisfies the Message interface.
+func (m *PriceLevelUpdate) Type() MessageType { return MessageType(m.Side) }
+
+// IsComplete returns true if this is the last update of an event, meaning the book
+// is consistent once it has been applied.
+func (m *PriceLevelUpdate) IsComplete() bool { return m.Flags&0x01 != 0 }
+
+// Unknown is a message whose type this package does not decode, such as messages
+// added in a later version of the protocol.
+type Unknown struct {
+	MessageType MessageType
+	Data        []byte
+}
+
+// Type satisfies the Message interface.
+func (m *Unknown) Type() MessageType { return m.MessageType }
+
+// decoder decodes IEX-TP messages. It interns symbols, since a day's file repeats
+// a few thousand symbols hundreds of millions of times.
+type decoder struct {
+	symbols map[[8]byte]string
+}
+
+// decode decodes a single IEX-TP message. The data is not retained.
+func (d *decoder) decode(data []byte) (Message, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty message")
+	}
+	typ := MessageType(data[0])
+	if want := messageLength(typ); len(data) < want {
+		return nil, fmt.Errorf("%q message is %d bytes, want %d", typ, len(data), want)
+	}
+
+	switch typ {
+	case MessageSystemEvent:
+		return &SystemEvent{
+			Event:     iex.SystemEventCode(data[1:2]),
+			Timestamp: timestamp(data[2:]),
+		}, nil
+	case MessageSecurityDirectory:
+		return &SecurityDirectory{
+			Flags:            data[1],
+			Timestamp:        timestamp(data[2:]),
+			Symbol:           d.symbol(data[10:]),
+			RoundLotSize:     binary.LittleEndian.Uint32(data[18:]),
+			AdjustedPOCPrice: price(data[22:]),
+			LULDTier:         data[30],
+		}, nil
+	case MessageTradingStatus:
+		return &TradingStatus{
+			Status:    iex.TradingStatusCode(data[1:2]),
+			Timestamp: timestamp(data[2:]),
+			Symbol:    d.symbol(data[10:]),
+			Reason:    iex.ReasonCode(strings.TrimSpace(string(data[18:22]))),
+		}, nil
+	case MessageOpHaltStatus:
+		return &OpHaltStatus{
+			IsHalted:  data[1] == 'O',
+			Timestamp: timestamp(data[2:]),
+			Symbol:    d.symbol(data[10:]),
+		}, nil
+	case MessageSSRStatus:
+		return &SSRStatus{
+			IsSSR:     data[1] == 1,
+			Timestamp: timestamp(data[2:]),
+			Symbol:    d.symbol(data[10:]),
+			Detail:    iex.SSRDetail(strings.TrimSpace(string(data[18:19]))),
+		}, nil
+	case MessageSecurityEvent:
+		return &SecurityEvent{
+			Event:     securityEvents[data[1]],
+			Timestamp: timestamp(data[2:]),
+			Symbol:    d.symbol(data[10:]),
+		}, nil
+	case MessageQuoteUpdate:
+		return &QuoteUpdate{
+			Flags:     data[1],
+			Timestamp: timestamp(data[2:]),
+			Symbol:    d.symbol(data[10:]),
+			BidSize:   binary.LittleEndian.Uint32(data[18:]),
+			BidPrice:  price(data[22:]),
+			AskPrice:  price(data[30:]),
+			AskSize:   binary.LittleEndian.Uint32(data[38:]),
+		}, nil
+	case MessageTradeReport:
+		return d.tradeReport(data), nil
+	case MessageTradeBreak:
+		return &TradeBreak{TradeReport: *d.tradeReport(data)}, nil
+	case MessageOfficialPrice:
+		return &OfficialPrice{
+			PriceType: priceTypes[data[1]],
+			Timestamp: timestamp(data[2:]),
+			Symbol:    d.symbol(data[10:]),
+			Price:     price(data[18:]),
+		}, nil
+	case MessageAuctionInformation:
+		return &AuctionInformation{
+			AuctionType:              auctionTypes[data[1]],
+			Timestamp:                timestamp(data[2:]),
+			Symbol:                   d.symbol(data[10:]),
+			PairedShares:             binary.LittleEndian.Uint32(data[18:]),
+			ReferencePrice:           price(data[22:]),
+			IndicativeClearingPrice:  price(data[30:]),
+			ImbalanceShares:          binary.LittleEndian.Uint32(data[38:]),
+			ImbalanceSide:            imbalanceSides[data[42]],
+			ExtensionNumber:          data[43],
+			ScheduledAuctionTime:     time.Unix(int64(binary.LittleEndian.Uint32(data[44:])), 0),
+			AuctionBookClearingPrice: price(data[48:]),
+			CollarReferencePrice:     price(data[56:]),
+			LowerAuctionCollar:       price(data[64:]),
+			UpperAuctionCollar:       price(data[72:]),
+		}, nil
+	case MessagePriceLevelUpdateBuy, MessagePriceLevelUpdateSell:
+		return &PriceLevelUpdate{
+			Side:      Side(typ),
+			Flags:     data[1],
+			Timestamp: timestamp(data[2:]),
+			Symbol:    d.symbol(data[10:]),
+			Size:      binary.LittleEndian.Uint32(data[18:]),
+			Price:     price(data[22:]),
+		}, nil
+	}
+	return &Unknown{MessageType: typ, Data: append([]byte(nil), data...)}, nil
+}
+
+// messageLength returns the length of a message of the given type, or one for unknown types.
+func messageLength(typ MessageType) int {
+	switch typ {
+	case MessageSystemEvent:
+		return systemEventLength
+	case MessageSecurityDirectory:
+		return securityDirectoryLength
+	case MessageTradingStatus:
+		return tradingStatusLength
+	case MessageOpHaltStatus:
+		return opHaltStatusLength
+	case MessageSSRStatus:
+		return ssrStatusLength
+	case MessageSecurityEvent:
+		return securityEventLength
+	case MessageQuoteUpdate:
+		return quoteUpdateLength
+	case MessageTradeReport, MessageTradeBreak:
+		return tradeReportLength
+	case MessageOfficialPrice:
+		return officialPriceLength
+	case MessageAuctionInformation:
+		return auctionInformationLength
+	case MessagePriceLevelUpdateBuy, MessagePriceLevelUpdateSell:
+		return priceLevelUpdateLength
+	}
+	return 1
+}
+
+// tradeReport decodes the layout shared by trade reports and trade breaks.
+func (d *decoder) tradeReport(data []byte) *TradeReport {
+	return &TradeReport{
+		Flags:     data[1],
+		Timestamp: timestamp(data[2:]),
+		Symbol:    d.symbol(data[10:]),
+		Size:      binary.LittleEndian.Uint32(data[18:]),
+		Price:     price(data[22:]),
+		TradeID:   int64(binary.LittleEndian.Uint64(data[30:])),
+	}
+}
+
+// These map the single byte codes of IEX-TP to the values used by the DEEP endpoints.
+var (
+	securityEvents = map[byte]iex.SecurityEventType{
+		'O': iex.SecurityEventMarketOpen,
+		'C': iex.SecurityEventMarketClose,
+	}
+	priceTypes = map[byte]iex.PriceType{
+		'Q': iex.PriceTypeOpen,
+		'M': iex.PriceTypeClose,
+	}
+	auctionTypes = map[byte]iex.AuctionType{
+		'O': iex.AuctionOpen,
+		'C': iex.AuctionClose,
+		'I': iex.AuctionIPO,
+		'H': iex.AuctionHalt,
+		'V': iex.AuctionVolatility,
+	}
+	imbalanceSides = map[byte]iex.ImbalanceSide{
+		'B': iex.ImbalanceBuy,
+		'S': iex.ImbalanceSell,
+		'N': iex.ImbalanceNone,
+	}
+)
+
+// timestamp decodes nanoseconds since the epoch.
+func timestamp(data []byte) time.Time {
+	return time.Unix(0, int64(binary.LittleEndian.Uint64(data)))
+}
+
+// price decodes a fixed point price with four decimal places.
+func price(data []byte) float64 {
+	return float64(int64(binary.LittleEndian.Uint64(data))) / priceScale
+}
+
+// symbol decodes a space padded symbol.
+func (d *decoder) symbol(data []byte) string {
+	var key [8]byte
+	copy(key[:], data)
+	if sym, ok := d.symbols[key]; ok {
+		return sym
+	}
+	if d.symbols == nil {
+		d.symbols = make(map[[8]byte]string)
+	}
+	sym := strings.TrimRight(string(key[:]), " ")
+	d.symbols[key] = sym
+	return sym
+}
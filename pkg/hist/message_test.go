@@ -0,0 +1,77 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package hist_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+	. "github.com/onwsk8r/goiex/pkg/hist"
+)
+
+var _ = Describe("Messages", func() {
+	Describe("TradeReport", func() {
+		It("should convert to a stock.Trade", func() {
+			ts := time.Now()
+			t := TradeReport{Flags: 0x80 | 0x20 | 0x08, Timestamp: ts, Symbol: "AAPL", Size: 20, Price: 144.57, TradeID: 7}
+			Expect(t.ToTrade()).To(Equal(stock.Trade{
+				Price:              144.57,
+				Size:               20,
+				TradeID:            7,
+				IsISO:              true,
+				IsOddLot:           true,
+				IsSinglePriceCross: true,
+				Timestamp:          ts,
+			}))
+		})
+		It("should keep its type when embedded in a TradeBreak", func() {
+			var m Message = &TradeBreak{}
+			Expect(m.Type()).To(Equal(MessageTradeBreak))
+		})
+	})
+
+	Describe("QuoteUpdate", func() {
+		It("should decode the flags", func() {
+			q := QuoteUpdate{}
+			Expect(q.IsActive()).To(BeTrue())
+			Expect(q.IsRegularHours()).To(BeTrue())
+			q.Flags = 0xc0
+			Expect(q.IsActive()).To(BeFalse())
+			Expect(q.IsRegularHours()).To(BeFalse())
+		})
+	})
+
+	Describe("SecurityDirectory", func() {
+		It("should decode the flags", func() {
+			d := SecurityDirectory{Flags: 0x40}
+			Expect(d.IsTestSecurity()).To(BeFalse())
+			Expect(d.IsWhenIssued()).To(BeTrue())
+			Expect(d.IsETP()).To(BeFalse())
+		})
+	})
+
+	Describe("PriceLevelUpdate", func() {
+		It("should report its side as its type", func() {
+			p := PriceLevelUpdate{Side: SideSell, Flags: 1}
+			Expect(p.Type()).To(Equal(MessagePriceLevelUpdateSell))
+			Expect(p.IsComplete()).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,211 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package hist reads the IEX HIST files, which are captures of the IEX TOPS and DEEP
+// feeds in pcap or pcapng format, optionally gzipped. Every packet carries an IEX-TP
+// segment of one or more messages, which Scanner decodes one message at a time.
+//
+// A typical loop looks like this:
+//
+//	s, err := hist.NewScanner(file)
+//	if err != nil {
+//		return err
+//	}
+//	for s.Scan() {
+//		switch msg := s.Message().(type) {
+//		case *hist.QuoteUpdate:
+//			...
+//		case *hist.TradeReport:
+//			...
+//		}
+//	}
+//	return s.Err()
+//
+// https://iextrading.com/trading/market-data/#hist
+// https://iextrading.com/docs/IEX%20Transport%20Specification.pdf
+package hist
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// These are the message protocol IDs of the feeds found in HIST files.
+const (
+	ProtocolTOPS uint16 = 0x8003
+	ProtocolDEEP uint16 = 0x8004
+)
+
+// segmentHeaderLength is the length of the IEX-TP header at the start of each packet.
+const segmentHeaderLength = 40
+
+// bufferSize is the size of the read buffer. HIST files are several gigabytes, so
+// reading them in large chunks matters.
+const bufferSize = 1 << 20
+
+// Segment is the IEX-TP header of a packet, which describes the messages it contains.
+type Segment struct {
+	Version       uint8
+	ProtocolID    uint16
+	ChannelID     uint32
+	SessionID     uint32
+	PayloadLength uint16
+	MessageCount  uint16
+	// StreamOffset is the byte offset of the first message in the session.
+	StreamOffset int64
+	// FirstSequence is the sequence number of the first message in the segment.
+	FirstSequence int64
+	SendTime      time.Time
+	// CaptureTime is the time the packet was captured, if the capture file records it.
+	CaptureTime time.Time
+}
+
+// Scanner reads the messages of a HIST file. Successive calls to Scan step through
+// the messages in the file, and Message returns the current one.
+// Packets that are not UDP over IPv4, such as ARP, are skipped.
+type Scanner struct {
+	packets  packetReader
+	decoder  decoder
+	segment  Segment
+	payload  []byte
+	index    int
+	message  Message
+	sequence int64
+	err      error
+}
+
+// NewScanner returns a Scanner reading from r, which may be a pcap or pcapng file,
+// or either compressed with gzip. It will return an error if the format is not recognized.
+func NewScanner(r io.Reader) (*Scanner, error) {
+	br := bufio.NewReaderSize(r, bufferSize)
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("could not read the gzip header: %w", err)
+		}
+		br = bufio.NewReaderSize(gz, bufferSize)
+	}
+
+	packets, err := newPacketReader(br)
+	if err != nil {
+		return nil, err
+	}
+	return &Scanner{packets: packets}, nil
+}
+
+// Scan advances the Scanner to the next message, which will then be available through
+// the Message method. It returns false when the scan stops, either by reaching the end
+// of the file or an error. After Scan returns false, the Err method will return any
+// error that occurred during scanning, except that if it was io.EOF, Err will return nil.
+func (s *Scanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+	for len(s.payload) == 0 {
+		if s.err = s.nextSegment(); s.err != nil {
+			s.message = nil
+			return false
+		}
+	}
+
+	if len(s.payload) < 2 {
+		s.err = fmt.Errorf("message %d: truncated message length", s.segment.FirstSequence+int64(s.index))
+		return false
+	}
+	length := int(binary.LittleEndian.Uint16(s.payload))
+	if len(s.payload) < 2+length {
+		s.err = fmt.Errorf("message %d is %d bytes, but only %d remain in the segment",
+			s.segment.FirstSequence+int64(s.index), length, len(s.payload)-2)
+		return false
+	}
+	if s.message, s.err = s.decoder.decode(s.payload[2 : 2+length]); s.err != nil {
+		s.err = fmt.Errorf("message %d: %w", s.segment.FirstSequence+int64(s.index), s.err)
+		return false
+	}
+	s.payload = s.payload[2+length:]
+	s.sequence = s.segment.FirstSequence + int64(s.index)
+	s.index++
+	return true
+}
+
+// Message returns the most recent message read by Scan.
+// It is not modified by later calls to Scan, so it can be retained.
+func (s *Scanner) Message() Message {
+	return s.message
+}
+
+// Sequence returns the sequence number of the most recent message read by Scan.
+func (s *Scanner) Sequence() int64 {
+	return s.sequence
+}
+
+// Segment returns the IEX-TP header of the packet containing the most recent message
+// read by Scan. It is overwritten by later calls to Scan.
+func (s *Scanner) Segment() *Segment {
+	return &s.segment
+}
+
+// Err returns the first non-EOF error that was encountered by the Scanner.
+func (s *Scanner) Err() error {
+	if s.err == io.EOF {
+		return nil
+	}
+	return s.err
+}
+
+// nextSegment reads packets until it finds an IEX-TP segment with messages.
+func (s *Scanner) nextSegment() error {
+	for {
+		data, linkType, captured, err := s.packets.next()
+		if err != nil {
+			return err
+		}
+		payload, ok := udpPayload(data, linkType)
+		if !ok {
+			continue
+		}
+		if len(payload) < segmentHeaderLength {
+			return fmt.Errorf("IEX-TP segment is %d bytes, want at least %d", len(payload), segmentHeaderLength)
+		}
+
+		s.segment = Segment{
+			Version:       payload[0],
+			ProtocolID:    binary.LittleEndian.Uint16(payload[2:]),
+			ChannelID:     binary.LittleEndian.Uint32(payload[4:]),
+			SessionID:     binary.LittleEndian.Uint32(payload[8:]),
+			PayloadLength: binary.LittleEndian.Uint16(payload[12:]),
+			MessageCount:  binary.LittleEndian.Uint16(payload[14:]),
+			StreamOffset:  int64(binary.LittleEndian.Uint64(payload[16:])),
+			FirstSequence: int64(binary.LittleEndian.Uint64(payload[24:])),
+			SendTime:      time.Unix(0, int64(binary.LittleEndian.Uint64(payload[32:]))),
+			CaptureTime:   captured,
+		}
+		payload = payload[segmentHeaderLength:]
+		if int(s.segment.PayloadLength) > len(payload) {
+			return fmt.Errorf("segment %d: payload is %d bytes, but the header says %d",
+				s.segment.FirstSequence, len(payload), s.segment.PayloadLength)
+		}
+		s.payload = payload[:s.segment.PayloadLength]
+		s.index = 0
+		if len(s.payload) > 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,327 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package hist_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onwsk8r/goiex/pkg/core/iex"
+	. "github.com/onwsk8r/goiex/pkg/hist"
+	"github.com/onwsk8r/goiex/test/helper"
+)
+
+// ns returns the time of a nanosecond epoch timestamp, as written in the fixtures.
+func ns(n int64) time.Time { return time.Unix(0, n) }
+
+// scanAll returns every message in the testdata file along with its sequence number.
+func scanAll(path string) ([]Message, []int64, []Segment) {
+	rc, err := helper.Testdata(path)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	defer rc.Close()
+
+	s, err := NewScanner(rc)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	var messages []Message
+	var sequences []int64
+	var segments []Segment
+	for s.Scan() {
+		messages = append(messages, s.Message())
+		sequences = append(sequences, s.Sequence())
+		segments = append(segments, *s.Segment())
+	}
+	ExpectWithOffset(1, s.Err()).ToNot(HaveOccurred())
+	return messages, sequences, segments
+}
+
+var _ = Describe("Scanner", func() {
+	t0 := time.Date(2021, time.July, 8, 11, 0, 0, 0, time.UTC)
+
+	// The TOPS fixture ends with a retail liquidity indicator, which is not decoded
+	retailLiquidity := make([]byte, 18)
+	copy(retailLiquidity, "IA")
+	binary.LittleEndian.PutUint64(retailLiquidity[2:], 1625774400500000000)
+	copy(retailLiquidity[10:], "AAPL    ")
+
+	Describe("TOPS", func() {
+		var expected []Message
+		BeforeEach(func() {
+			expected = []Message{
+				&SystemEvent{Event: iex.SystemEventStartOfMessages, Timestamp: t0},
+				&SecurityDirectory{
+					Flags:            0x20,
+					Timestamp:        t0.Add(time.Second),
+					Symbol:           "SPY",
+					RoundLotSize:     100,
+					AdjustedPOCPrice: 430.92,
+					LULDTier:         1,
+				},
+				&TradingStatus{Status: iex.TradingStatusTrading, Timestamp: t0.Add(2 * time.Second), Symbol: "AAPL"},
+				&QuoteUpdate{
+					Timestamp: ns(1625774398980123456),
+					Symbol:    "AAPL",
+					BidSize:   100,
+					BidPrice:  144.55,
+					AskPrice:  144.58,
+					AskSize:   200,
+				},
+				&TradeReport{
+					Flags:     0x20,
+					Timestamp: ns(1625774397355000001),
+					Symbol:    "AAPL",
+					Size:      20,
+					Price:     144.57,
+					TradeID:   2030142875,
+				},
+				&OfficialPrice{PriceType: iex.PriceTypeClose, Timestamp: ns(1625774400412000000), Symbol: "AAPL", Price: 144.57},
+				&Unknown{MessageType: 'I', Data: retailLiquidity},
+			}
+		})
+
+		It("should decode every message of a pcap file", func() {
+			messages, sequences, _ := scanAll("hist/tops.pcap")
+			Expect(cmp.Equal(expected, messages)).To(BeTrue(), cmp.Diff(expected, messages))
+			Expect(sequences).To(Equal([]int64{1, 2, 3, 4, 5, 6, 7}))
+		})
+
+		It("should decode a gzipped pcap file with nanosecond timestamps", func() {
+			messages, _, segments := scanAll("hist/tops.pcap.gz")
+			Expect(cmp.Equal(expected, messages)).To(BeTrue(), cmp.Diff(expected, messages))
+			Expect(segments[6].CaptureTime.Equal(ns(1625774399000000000))).To(BeTrue())
+		})
+
+		It("should report the segment of each message", func() {
+			_, _, segments := scanAll("hist/tops.pcap")
+			expected := Segment{
+				Version:       1,
+				ProtocolID:    ProtocolTOPS,
+				ChannelID:     1,
+				SessionID:     1150681088,
+				PayloadLength: 69,
+				MessageCount:  3,
+				FirstSequence: 1,
+				SendTime:      t0.Add(3 * time.Second),
+				CaptureTime:   t0.Add(3 * time.Second),
+			}
+			Expect(cmp.Equal(expected, segments[0])).To(BeTrue(), cmp.Diff(expected, segments[0]))
+			Expect(segments[3].FirstSequence).To(Equal(int64(4)))
+			Expect(segments[3].StreamOffset).To(Equal(int64(100)))
+			Expect(segments[3].MessageCount).To(Equal(uint16(4)))
+		})
+	})
+
+	Describe("DEEP", func() {
+		It("should decode every message of a pcapng file", func() {
+			expected := []Message{
+				&SystemEvent{Event: iex.SystemEventStartOfRegularHours, Timestamp: ns(1625751000000000000)},
+				&SecurityEvent{Event: iex.SecurityEventMarketOpen, Timestamp: ns(1625751000000000500), Symbol: "ZIEXT"},
+				&PriceLevelUpdate{
+					Side:      SideBuy,
+					Flags:     1,
+					Timestamp: ns(1625751000100000000),
+					Symbol:    "ZIEXT",
+					Size:      100,
+					Price:     1.05,
+				},
+				&PriceLevelUpdate{
+					Side:      SideSell,
+					Timestamp: ns(1625751000100000001),
+					Symbol:    "ZIEXT",
+					Size:      300,
+					Price:     1.06,
+				},
+				&TradingStatus{
+					Status:    iex.TradingStatusHalted,
+					Timestamp: ns(1625752800000000000),
+					Symbol:    "ZIEXT",
+					Reason:    iex.ReasonHaltNewsPending,
+				},
+				&OpHaltStatus{IsHalted: true, Timestamp: ns(1625752800000000000), Symbol: "ZIEXT"},
+				&SSRStatus{IsSSR: true, Timestamp: ns(1625752800000000001), Symbol: "ZIEXT", Detail: iex.SSRDetailActivated},
+				&AuctionInformation{
+					AuctionType:              iex.AuctionClose,
+					Timestamp:                ns(1625773800394000000),
+					Symbol:                   "ZIEXT",
+					PairedShares:             3600,
+					ReferencePrice:           1.05,
+					IndicativeClearingPrice:  1.05,
+					ImbalanceShares:          600,
+					ImbalanceSide:            iex.ImbalanceBuy,
+					ScheduledAuctionTime:     time.Date(2021, time.July, 8, 20, 0, 0, 0, time.UTC),
+					AuctionBookClearingPrice: 1.05,
+					CollarReferencePrice:     1.05,
+					LowerAuctionCollar:       0.59,
+					UpperAuctionCollar:       1.59,
+				},
+				&TradeBreak{TradeReport{
+					Flags:     0x80,
+					Timestamp: ns(1625773900000000000),
+					Symbol:    "ZIEXT",
+					Size:      100,
+					Price:     1.05,
+					TradeID:   42,
+				}},
+			}
+			messages, sequences, segments := scanAll("hist/deep.pcapng")
+			Expect(cmp.Equal(expected, messages)).To(BeTrue(), cmp.Diff(expected, messages))
+			Expect(sequences).To(Equal([]int64{1, 2, 3, 4, 5, 6, 7, 8, 9}))
+			Expect(segments[0].ProtocolID).To(Equal(ProtocolDEEP))
+			Expect(segments[8].CaptureTime.Equal(ns(1625773900000000001))).To(BeTrue())
+		})
+	})
+
+	Describe("Errors", func() {
+		var data []byte
+		BeforeEach(func() {
+			rc, err := helper.Testdata("hist/tops.pcap")
+			Expect(err).ToNot(HaveOccurred())
+			defer rc.Close()
+			data, err = ioutil.ReadAll(rc)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reject files that are not captures", func() {
+			_, err := NewScanner(bytes.NewReader([]byte(`{"symbol":"AAPL"}`)))
+			Expect(err).To(MatchError(ContainSubstring("unknown capture format")))
+		})
+		It("should reject empty files", func() {
+			_, err := NewScanner(bytes.NewReader(nil))
+			Expect(err).To(HaveOccurred())
+		})
+		It("should return an error for a truncated file", func() {
+			s, err := NewScanner(bytes.NewReader(data[:len(data)-10]))
+			Expect(err).ToNot(HaveOccurred())
+			count := 0
+			for s.Scan() {
+				count++
+			}
+			Expect(count).To(Equal(3))
+			Expect(s.Err()).To(MatchError(ContainSubstring("truncated packet")))
+			Expect(rootCause(s.Err())).To(Equal(io.ErrUnexpectedEOF))
+		})
+		It("should return an error for a truncated message", func() {
+			// The length of the first message of the first segment
+			data[24+16+42+40] = 0xff
+			s, err := NewScanner(bytes.NewReader(data))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.Scan()).To(BeFalse())
+			Expect(s.Err()).To(MatchError(ContainSubstring("message 1 is 255 bytes, but only 67 remain")))
+			Expect(s.Message()).To(BeNil())
+		})
+		It("should return an error for a message shorter than its type", func() {
+			// Turn the system event into a trade report
+			data[24+16+42+40+2] = 'T'
+			s, err := NewScanner(bytes.NewReader(data))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.Scan()).To(BeFalse())
+			Expect(s.Err()).To(MatchError(`message 1: 'T' message is 10 bytes, want 38`))
+		})
+		It("should return an error for a short interface description block", func() {
+			s, err := NewScanner(bytes.NewReader(pcapng(pcapngBlock(1, make([]byte, 4)))))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.Scan()).To(BeFalse())
+			Expect(s.Err()).To(MatchError(ContainSubstring("invalid pcapng interface description block")))
+		})
+		It("should return an error for an unsupported timestamp resolution", func() {
+			for _, resolution := range []byte{10, 19, 0x80 | 30, 0xff} {
+				// The link type, reserved field and snap length, then if_tsresol and the end of options
+				idb := []byte{1, 0, 0, 0, 0, 0, 0, 0, 9, 0, 1, 0, resolution, 0, 0, 0, 0, 0, 0, 0}
+				s, err := NewScanner(bytes.NewReader(pcapng(pcapngBlock(1, idb))))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(s.Scan()).To(BeFalse())
+				Expect(s.Err()).To(MatchError(ContainSubstring("unsupported pcapng timestamp resolution")))
+			}
+		})
+	})
+})
+
+// pcapng returns a little endian pcapng file made of a section header and the given blocks.
+func pcapng(blocks ...[]byte) []byte {
+	// The byte order magic, version 1.0 and an unspecified section length
+	shb := []byte{0x4D, 0x3C, 0x2B, 0x1A, 1, 0, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	data := pcapngBlock(0x0A0D0D0A, shb)
+	for _, block := range blocks {
+		data = append(data, block...)
+	}
+	return data
+}
+
+// pcapngBlock returns a little endian pcapng block with the given type and body,
+// which must be a multiple of four bytes long.
+func pcapngBlock(blockType uint32, body []byte) []byte {
+	length := uint32(len(body) + 12)
+	block := make([]byte, 8, length)
+	binary.LittleEndian.PutUint32(block, blockType)
+	binary.LittleEndian.PutUint32(block[4:], length)
+	block = append(block, body...)
+	return append(block, block[4:8]...)
+}
+
+// rootCause unwraps err to its root cause.
+func rootCause(err error) error {
+	for {
+		u, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			return err
+		}
+		err = u.Unwrap()
+	}
+}
+
+// BenchmarkScanner measures the cost of scanning a message, using a file made of
+// the TOPS fixture's packets repeated many times.
+func BenchmarkScanner(b *testing.B) {
+	rc, err := helper.Testdata("hist/tops.pcap")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer rc.Close()
+	fixture, err := ioutil.ReadAll(rc)
+	if err != nil {
+		b.Fatal(err)
+	}
+	const repeat = 10000
+	data := append([]byte(nil), fixture[:24]...)
+	for i := 0; i < repeat; i++ {
+		data = append(data, fixture[24:]...)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	start, messages := time.Now(), 0
+	for i := 0; i < b.N; i++ {
+		s, err := NewScanner(bytes.NewReader(data))
+		if err != nil {
+			b.Fatal(err)
+		}
+		for s.Scan() {
+			messages++
+		}
+		if err = s.Err(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(time.Since(start).Nanoseconds())/float64(messages), "ns/msg")
+}
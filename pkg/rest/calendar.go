@@ -0,0 +1,302 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Calendar answers questions about which days the U.S. equity markets are open.
+// Only the calendar date of each time.Time argument is used, and the returned dates
+// are at midnight UTC.
+type Calendar interface {
+	IsTradingDay(ctx context.Context, t time.Time) (bool, error)
+	NextTradingDay(ctx context.Context, t time.Time) (time.Time, error)
+	PreviousTradingDay(ctx context.Context, t time.Time) (time.Time, error)
+	TradingDaysBetween(ctx context.Context, from, to time.Time) ([]time.Time, error)
+}
+
+// maxNonTradingDays bounds the search for the next or previous trading day.
+const maxNonTradingDays = 14
+
+// RuleCalendar is a Calendar that derives holidays from the exchange's rules using
+// IsTradingDay. It never makes a request or returns an error, but it does not know
+// about unscheduled closures. It is the default Calendar of Stock.
+type RuleCalendar struct{}
+
+// IsTradingDay satisfies the Calendar interface.
+func (RuleCalendar) IsTradingDay(_ context.Context, t time.Time) (bool, error) {
+	return IsTradingDay(t), nil
+}
+
+// NextTradingDay satisfies the Calendar interface.
+func (c RuleCalendar) NextTradingDay(ctx context.Context, t time.Time) (time.Time, error) {
+	return step(ctx, c, t, 1)
+}
+
+// PreviousTradingDay satisfies the Calendar interface.
+func (c RuleCalendar) PreviousTradingDay(ctx context.Context, t time.Time) (time.Time, error) {
+	return step(ctx, c, t, -1)
+}
+
+// TradingDaysBetween satisfies the Calendar interface.
+func (RuleCalendar) TradingDaysBetween(_ context.Context, from, to time.Time) ([]time.Time, error) {
+	return tradingDays(from, to), nil
+}
+
+// TradingCalendar is a Calendar backed by the holidays from Reference.USDates.
+// Holidays are fetched once per calendar year and cached, so a TradingCalendar
+// should be reused. It is safe for concurrent use.
+type TradingCalendar struct {
+	ref      *Reference
+	mu       sync.Mutex
+	holidays map[int]map[time.Time]bool
+	pending  map[int]*holidayFetch
+}
+
+// holidayFetch is an in-flight request for the holidays of one year.
+// done is closed once holidays and err are set.
+type holidayFetch struct {
+	done     chan struct{}
+	holidays map[time.Time]bool
+	err      error
+}
+
+// NewTradingCalendar creates a TradingCalendar that fetches holidays with the given Reference.
+func NewTradingCalendar(ref *Reference) *TradingCalendar {
+	return &TradingCalendar{
+		ref:      ref,
+		holidays: make(map[int]map[time.Time]bool),
+		pending:  make(map[int]*holidayFetch),
+	}
+}
+
+// HolidaysPerYear is the number of holidays TradingCalendar requests for each year.
+// It exceeds the number of scheduled holidays to leave room for unscheduled closures.
+var HolidaysPerYear = 20
+
+// yearHolidays returns the holidays in the given year, fetching them if they are not cached.
+// The lock is not held during the fetch, so other years can be looked up meanwhile, and
+// concurrent callers for the same year wait for a single fetch. That fetch uses the context
+// of the caller that started it; failures are not cached.
+func (c *TradingCalendar) yearHolidays(ctx context.Context, year int) (map[time.Time]bool, error) {
+	c.mu.Lock()
+	if holidays, ok := c.holidays[year]; ok {
+		c.mu.Unlock()
+		return holidays, nil
+	}
+	fetch, waiting := c.pending[year]
+	if !waiting {
+		fetch = &holidayFetch{done: make(chan struct{})}
+		c.pending[year] = fetch
+	}
+	c.mu.Unlock()
+
+	if !waiting {
+		fetch.holidays, fetch.err = c.fetchHolidays(ctx, year)
+		c.mu.Lock()
+		if fetch.err == nil {
+			c.holidays[year] = fetch.holidays
+		}
+		delete(c.pending, year)
+		c.mu.Unlock()
+		close(fetch.done)
+	}
+
+	select {
+	case <-fetch.done:
+		return fetch.holidays, fetch.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fetchHolidays requests the holidays in the given year.
+func (c *TradingCalendar) fetchHolidays(ctx context.Context, year int) (map[time.Time]bool, error) {
+	start := time.Date(year-1, time.December, 31, 0, 0, 0, 0, time.UTC)
+	dates, err := c.ref.USDates(ctx, DateTypeHoliday, DateDirectionNext, HolidaysPerYear, start)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the holidays for %d: %w", year, err)
+	}
+	holidays := make(map[time.Time]bool)
+	for idx := range dates {
+		if dates[idx].Date.Year() == year {
+			holidays[day(dates[idx].Date)] = true
+		}
+	}
+	return holidays, nil
+}
+
+// IsTradingDay satisfies the Calendar interface.
+func (c *TradingCalendar) IsTradingDay(ctx context.Context, t time.Time) (bool, error) {
+	d := day(t)
+	if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+		return false, nil
+	}
+	holidays, err := c.yearHolidays(ctx, d.Year())
+	if err != nil {
+		return false, err
+	}
+	return !holidays[d], nil
+}
+
+// NextTradingDay satisfies the Calendar interface.
+func (c *TradingCalendar) NextTradingDay(ctx context.Context, t time.Time) (time.Time, error) {
+	return step(ctx, c, t, 1)
+}
+
+// PreviousTradingDay satisfies the Calendar interface.
+func (c *TradingCalendar) PreviousTradingDay(ctx context.Context, t time.Time) (time.Time, error) {
+	return step(ctx, c, t, -1)
+}
+
+// TradingDaysBetween satisfies the Calendar interface.
+func (c *TradingCalendar) TradingDaysBetween(ctx context.Context, from, to time.Time) (res []time.Time, err error) {
+	for _, d := range weekdays(from, to) {
+		var ok bool
+		if ok, err = c.IsTradingDay(ctx, d); err != nil {
+			return nil, err
+		} else if ok {
+			res = append(res, d)
+		}
+	}
+	return
+}
+
+// step returns the first trading day after t when direction is 1, or before t when it is -1.
+func step(ctx context.Context, c Calendar, t time.Time, direction int) (time.Time, error) {
+	d := day(t)
+	for i := 0; i < maxNonTradingDays; i++ {
+		d = d.AddDate(0, 0, direction)
+		if ok, err := c.IsTradingDay(ctx, d); err != nil {
+			return time.Time{}, err
+		} else if ok {
+			return d, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("no trading day within %d days of %s", maxNonTradingDays, t.Format("2006-01-02"))
+}
+
+// day truncates a time to midnight UTC of its calendar date.
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// weekdays returns the dates from through to, inclusive, that fall on a weekday.
+// It is an upper bound on the trading days in the interval.
+func weekdays(from, to time.Time) (res []time.Time) {
+	for d := day(from); !d.After(day(to)); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			res = append(res, d)
+		}
+	}
+	return
+}
+
+// IsTradingDay returns true if the NYSE is open on the date of t. Holidays are derived
+// from the exchange's rules rather than fetched, so unscheduled closures are not
+// included. Early closes count as trading days.
+// https://www.nyse.com/markets/hours-calendars
+func IsTradingDay(t time.Time) bool {
+	d := day(t)
+	if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+		return false
+	}
+	for _, holiday := range marketHolidays(d.Year()) {
+		if d.Equal(holiday) {
+			return false
+		}
+	}
+	return true
+}
+
+// tradingDays returns the dates from through to, inclusive, for which IsTradingDay is true.
+func tradingDays(from, to time.Time) (res []time.Time) {
+	for _, d := range weekdays(from, to) {
+		if IsTradingDay(d) {
+			res = append(res, d)
+		}
+	}
+	return
+}
+
+// marketHolidays returns the dates the NYSE is closed for holidays in the given year.
+// Holidays falling on a Sunday are observed the following Monday, and those falling
+// on a Saturday the preceding Friday, except New Year's Day, which is not observed
+// in the prior year.
+func marketHolidays(year int) []time.Time {
+	date := func(month time.Month, d int) time.Time { return time.Date(year, month, d, 0, 0, 0, 0, time.UTC) }
+	observed := func(d time.Time) time.Time {
+		switch d.Weekday() {
+		case time.Saturday:
+			return d.AddDate(0, 0, -1)
+		case time.Sunday:
+			return d.AddDate(0, 0, 1)
+		}
+		return d
+	}
+
+	res := []time.Time{
+		observed(date(time.July, 4)),
+		observed(date(time.December, 25)),
+		nthWeekday(year, time.February, time.Monday, 3),
+		nthWeekday(year, time.May, time.Monday, -1),
+		nthWeekday(year, time.September, time.Monday, 1),
+		nthWeekday(year, time.November, time.Thursday, 4),
+		easter(year).AddDate(0, 0, -2),
+	}
+	if newYear := date(time.January, 1); newYear.Weekday() != time.Saturday {
+		res = append(res, observed(newYear))
+	}
+	if year >= 1998 { // nolint:gomnd
+		res = append(res, nthWeekday(year, time.January, time.Monday, 3))
+	}
+	if year >= 2022 { // nolint:gomnd
+		res = append(res, observed(date(time.June, 19)))
+	}
+	return res
+}
+
+// nthWeekday returns the nth occurrence of weekday in the month, or the last if n is negative.
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	if n < 0 {
+		d := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+		return d.AddDate(0, 0, -((int(d.Weekday()) - int(weekday) + 7) % 7)) // nolint:gomnd
+	}
+	d := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return d.AddDate(0, 0, (int(weekday)-int(d.Weekday())+7)%7+(n-1)*7) // nolint:gomnd
+}
+
+// easter returns the date of Easter Sunday in the Gregorian calendar using the
+// anonymous Gregorian algorithm.
+func easter(year int) time.Time {
+	a := year % 19                     // nolint:gomnd
+	b, c := year/100, year%100         // nolint:gomnd
+	d, e := b/4, b%4                   // nolint:gomnd
+	f := (b + 8) / 25                  // nolint:gomnd
+	g := (b - f + 1) / 3               // nolint:gomnd
+	h := (19*a + b - d - g + 15) % 30  // nolint:gomnd
+	i, k := c/4, c%4                   // nolint:gomnd
+	l := (32 + 2*e + 2*i - h - k) % 7  // nolint:gomnd
+	m := (a + 11*h + 22*l) / 451       // nolint:gomnd
+	month := (h + l - 7*m + 114) / 31  // nolint:gomnd
+	dayOfMonth := (h+l-7*m+114)%31 + 1 // nolint:gomnd
+	return time.Date(year, time.Month(month), dayOfMonth, 0, 0, 0, 0, time.UTC)
+}
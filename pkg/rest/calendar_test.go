@@ -0,0 +1,158 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+// +build !integration
+
+package rest_test
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("IsTradingDay", func() {
+	DescribeTable("should return false for market holidays",
+		func(date string) {
+			d, err := time.Parse("2006-01-02", date)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(IsTradingDay(d)).To(BeFalse())
+		},
+		Entry("New Year's Day", "2021-01-01"),
+		Entry("Martin Luther King, Jr. Day", "2021-01-18"),
+		Entry("Washington's Birthday", "2021-02-15"),
+		Entry("Good Friday", "2021-04-02"),
+		Entry("Memorial Day", "2021-05-31"),
+		Entry("Independence Day observed on Monday", "2021-07-05"),
+		Entry("Labor Day", "2021-09-06"),
+		Entry("Thanksgiving Day", "2021-11-25"),
+		Entry("Christmas Day observed on Friday", "2021-12-24"),
+		Entry("Good Friday", "2022-04-15"),
+		Entry("Juneteenth observed on Monday", "2022-06-20"),
+		Entry("Christmas Day observed on Monday", "2022-12-26"),
+		Entry("New Year's Day observed on Monday", "2023-01-02"),
+	)
+	DescribeTable("should return true for trading days",
+		func(date string) {
+			d, err := time.Parse("2006-01-02", date)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(IsTradingDay(d)).To(BeTrue())
+		},
+		Entry("a regular weekday", "2021-06-30"),
+		Entry("the day before New Year's Day on a Saturday", "2021-12-31"),
+		Entry("the day after Thanksgiving", "2021-11-26"),
+		Entry("Juneteenth before 2022", "2021-06-18"),
+	)
+	It("should return false for weekends", func() {
+		Expect(IsTradingDay(time.Date(2021, time.July, 3, 0, 0, 0, 0, time.UTC))).To(BeFalse())
+		Expect(IsTradingDay(time.Date(2021, time.July, 4, 0, 0, 0, 0, time.UTC))).To(BeFalse())
+	})
+})
+
+var _ = Describe("RuleCalendar", func() {
+	var c RuleCalendar
+	date := func(month time.Month, d int) time.Time {
+		return time.Date(2021, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	It("should skip weekends and holidays when finding the next trading day", func() {
+		next, err := c.NextTradingDay(ctx, date(time.July, 2))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).To(Equal(date(time.July, 6)))
+	})
+	It("should skip weekends and holidays when finding the previous trading day", func() {
+		prev, err := c.PreviousTradingDay(ctx, date(time.July, 6))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(prev).To(Equal(date(time.July, 2)))
+	})
+	It("should list the trading days between two dates", func() {
+		days, err := c.TradingDaysBetween(ctx, date(time.July, 1), date(time.July, 6))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(days).To(Equal([]time.Time{date(time.July, 1), date(time.July, 2), date(time.July, 6)}))
+	})
+})
+
+var _ = Describe("TradingCalendar", func() {
+	var c *TradingCalendar
+	date := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	BeforeEach(func() {
+		c = NewTradingCalendar(NewReference(client))
+		// 2018 had an unscheduled closure on December 5th
+		holidays := []map[string]string{
+			{"date": "2018-01-01"}, {"date": "2018-12-05"}, {"date": "2018-12-25"}, {"date": "2019-01-01"},
+		}
+		httpmock.RegisterResponder("GET", "/v1/ref-data/us/dates/holiday/next/20/20171231",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, holidays))
+	})
+
+	It("should use the fetched holidays", func() {
+		ok, err := c.IsTradingDay(ctx, date(2018, time.December, 5))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeFalse())
+		Expect(IsTradingDay(date(2018, time.December, 5))).To(BeTrue())
+
+		ok, err = c.IsTradingDay(ctx, date(2018, time.December, 4))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeTrue())
+	})
+	It("should cache the holidays for each year", func() {
+		days, err := c.TradingDaysBetween(ctx, date(2018, time.December, 3), date(2018, time.December, 7))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(days).To(HaveLen(4))
+		next, err := c.NextTradingDay(ctx, date(2018, time.December, 4))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).To(Equal(date(2018, time.December, 6)))
+		prev, err := c.PreviousTradingDay(ctx, date(2018, time.December, 26))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(prev).To(Equal(date(2018, time.December, 24)))
+		Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+	})
+	It("should make a single request for concurrent callers", func() {
+		var wg sync.WaitGroup
+		for d := 3; d <= 7; d++ {
+			wg.Add(1)
+			go func(d int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				_, err := c.IsTradingDay(ctx, date(2018, time.December, d))
+				Expect(err).ToNot(HaveOccurred())
+			}(d)
+		}
+		wg.Wait()
+		Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+	})
+	It("should not make a request for weekends", func() {
+		ok, err := c.IsTradingDay(ctx, date(2018, time.December, 8))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeFalse())
+		Expect(httpmock.GetTotalCallCount()).To(BeZero())
+	})
+	It("should return an error if the holidays cannot be fetched", func() {
+		httpmock.RegisterResponder("GET", "/v1/ref-data/us/dates/holiday/next/20/20181231",
+			httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+		_, err := c.IsTradingDay(ctx, date(2019, time.January, 2))
+		Expect(err).To(HaveOccurred())
+	})
+})
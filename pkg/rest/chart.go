@@ -0,0 +1,263 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SortOrder is the order in which time series data is returned.
+type SortOrder string
+
+var (
+	SortAscending  SortOrder = "asc"
+	SortDescending SortOrder = "desc"
+)
+
+// ChartOptions holds the optional query string parameters accepted by the chart endpoints
+// used by Stock.Historical and Stock.HistoricalIntraday. The zero value of each field
+// leaves the corresponding parameter unset so the API default applies.
+// https://iexcloud.io/docs/api/#historical-prices
+type ChartOptions struct {
+	// CloseOnly returns only the adjusted date, close, and volume. Daily data only.
+	CloseOnly bool
+	// ByDay returns daily data for an ExactDate. Stock.Historical sets it automatically
+	// when the period is HistoricalPeriodDate, so it rarely needs to be set.
+	ByDay bool
+	// Simplify runs a polyline simplification using the Douglas-Peucker algorithm.
+	Simplify bool
+	// Interval returns every Nth element.
+	Interval int
+	// ChangeFromClose calculates changeOverTime from the previous close. Intraday data only.
+	ChangeFromClose bool
+	// Last returns the last N elements of the period.
+	Last int
+	// IncludeToday appends the current trading day to the result. Daily data only.
+	IncludeToday bool
+	// Sort is the order of the result: SortAscending or SortDescending.
+	Sort SortOrder
+	// ExactDate requests the data for a single day. It requires the "date" period and
+	// is sent as /chart/date/YYYYMMDD.
+	ExactDate time.Time
+}
+
+// validate returns an error if the options cannot be used with the given kind of chart.
+// Nil options are valid unless the period requires an exact date.
+func (o *ChartOptions) validate(intraday, date bool) error {
+	if o == nil {
+		if date {
+			return fmt.Errorf("chart options: the date period requires an exact date")
+		}
+		return nil
+	}
+	switch {
+	case date && o.ExactDate.IsZero():
+		return fmt.Errorf("chart options: the date period requires an exact date")
+	case !date && !o.ExactDate.IsZero():
+		return fmt.Errorf("chart options: an exact date requires the date period")
+	case o.Interval < 0:
+		return fmt.Errorf("chart options: interval must not be negative")
+	case o.Last < 0:
+		return fmt.Errorf("chart options: last must not be negative")
+	case o.Sort != "" && o.Sort != SortAscending && o.Sort != SortDescending:
+		return fmt.Errorf("chart options: invalid sort order %q", o.Sort)
+	case intraday && o.CloseOnly:
+		return fmt.Errorf("chart options: close only applies to daily data")
+	case intraday && o.ByDay:
+		return fmt.Errorf("chart options: by day returns daily data; use Historical")
+	case intraday && o.IncludeToday:
+		return fmt.Errorf("chart options: include today applies to daily data")
+	case !intraday && o.ChangeFromClose:
+		return fmt.Errorf("chart options: change from close applies to intraday data")
+	case !intraday && o.ByDay && !date:
+		return fmt.Errorf("chart options: by day requires the date period")
+	}
+	return nil
+}
+
+// query returns the query string parameters for the options.
+func (o *ChartOptions) query() map[string]string {
+	params := make(map[string]string)
+	if o == nil {
+		return params
+	}
+	setBool := func(key string, val bool) {
+		if val {
+			params[key] = "true"
+		}
+	}
+	setBool("chartCloseOnly", o.CloseOnly)
+	setBool("chartByDay", o.ByDay)
+	setBool("chartSimplify", o.Simplify)
+	setBool("changeFromClose", o.ChangeFromClose)
+	setBool("includeToday", o.IncludeToday)
+	if o.Interval > 0 {
+		params["chartInterval"] = strconv.Itoa(o.Interval)
+	}
+	if o.Last > 0 {
+		params["chartLast"] = strconv.Itoa(o.Last)
+	}
+	if o.Sort != "" {
+		params["sort"] = string(o.Sort)
+	}
+	return params
+}
+
+// path returns the chart endpoint path, which includes the date when ExactDate is set.
+// The range path parameter is unused in that case.
+func (o *ChartOptions) path(pathParams map[string]string) string {
+	if o != nil && !o.ExactDate.IsZero() {
+		pathParams["date"] = o.ExactDate.Format("20060102")
+		return "/{version}/stock/{symbol}/chart/date/{date}"
+	}
+	return "/{version}/stock/{symbol}/chart/{range}"
+}
+
+// These variables are used by Stock.PlanHistorical to estimate the credit cost of a request.
+// https://iexcloud.io/docs/api/#historical-prices
+var (
+	// CreditsPerHistoricalPoint is the cost of each daily data point returned by a range request.
+	CreditsPerHistoricalPoint = 10
+	// CreditsPerExactDate is the cost of a single exact date request with chartByDay.
+	CreditsPerExactDate = 10
+	// MaxExactDateCalls caps the number of exact date requests Stock.PlanHistorical will choose.
+	MaxExactDateCalls = 10
+)
+
+// historicalPeriods lists the range periods from smallest to largest.
+var historicalPeriods = []HistoricalPeriod{
+	HistoricalPeriod5d, HistoricalPeriod1m, HistoricalPeriod3m, HistoricalPeriod6m, HistoricalPeriodYTD,
+	HistoricalPeriod1y, HistoricalPeriod2y, HistoricalPeriod5y, HistoricalPeriodMax,
+}
+
+// HistoricalPlan describes the requests Stock.HistoricalBetween makes to cover From through To.
+// Either Period and Last are set, for a single range request, or ExactDates is set,
+// for one exact date request per day. Credits is the estimated cost of the requests.
+type HistoricalPlan struct {
+	From       time.Time
+	To         time.Time
+	Period     HistoricalPeriod
+	Last       int
+	ExactDates []time.Time
+	Credits    int
+}
+
+// HistoricalPlanHook is called by Stock.HistoricalBetween with the plan for a symbol
+// before any request is sent. Returning an error cancels the requests.
+type HistoricalPlanHook func(ctx context.Context, symbol string, plan HistoricalPlan) error
+
+// PlanHistorical returns the cheapest set of requests covering from through to, inclusive,
+// as of today, as HistoricalBetween would request it. Use it to preview the cost of a
+// request. To is moved back to today if it is later.
+// Range requests always end today, so a range request covers the smallest period
+// starting on or before from and uses chartLast to drop the older data points.
+// Exact date requests are used instead when they cost fewer credits and no more
+// than MaxExactDateCalls are needed. Trading days are counted with the Stock's Calendar,
+// which by default misses unscheduled closures; see NewStock.
+func (s *Stock) PlanHistorical(ctx context.Context, from, to, today time.Time) (plan HistoricalPlan, err error) {
+	from, to, today = day(from), day(to), day(today)
+	if to.After(today) {
+		to = today
+	}
+	switch {
+	case from.IsZero():
+		return plan, fmt.Errorf("from is missing")
+	case from.After(to):
+		return plan, fmt.Errorf("from (%s) is after to (%s)", from.Format("2006-01-02"), to.Format("2006-01-02"))
+	}
+
+	for _, period := range historicalPeriods {
+		if !periodStart(period, today).After(from) {
+			plan.Period = period
+			break
+		}
+	}
+	if plan.Period == "" {
+		return plan, fmt.Errorf("from (%s) is before the earliest available data", from.Format("2006-01-02"))
+	}
+	var days []time.Time
+	if days, err = s.calendar.TradingDaysBetween(ctx, from, today); err != nil {
+		return plan, err
+	}
+	plan.From, plan.To = from, to
+	plan.Last = len(days)
+	plan.Credits = plan.Last * CreditsPerHistoricalPoint
+
+	var dates []time.Time
+	for _, d := range days {
+		if !d.After(to) {
+			dates = append(dates, d)
+		}
+	}
+	if cost := len(dates) * CreditsPerExactDate; len(dates) <= MaxExactDateCalls && cost < plan.Credits {
+		plan = HistoricalPlan{From: from, To: to, ExactDates: dates, Credits: cost}
+	}
+	return plan, nil
+}
+
+// periodStart returns the earliest date a range request made today returns.
+func periodStart(period HistoricalPeriod, today time.Time) time.Time {
+	switch period {
+	case HistoricalPeriod5d:
+		days := weekdays(today.AddDate(0, 0, -14), today)
+		return days[len(days)-5]
+	case HistoricalPeriod1m:
+		return today.AddDate(0, -1, 0)
+	case HistoricalPeriod3m:
+		return today.AddDate(0, -3, 0)
+	case HistoricalPeriod6m:
+		return today.AddDate(0, -6, 0)
+	case HistoricalPeriodYTD:
+		return time.Date(today.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	case HistoricalPeriod1y:
+		return today.AddDate(-1, 0, 0)
+	case HistoricalPeriod2y:
+		return today.AddDate(-2, 0, 0)
+	case HistoricalPeriod5y:
+		return today.AddDate(-5, 0, 0)
+	case HistoricalPeriodMax:
+		return today.AddDate(-15, 0, 0) // nolint:gomnd
+	}
+	return today
+}
+
+// DayError is the error for a single day of a multi-day request.
+type DayError struct {
+	Date time.Time
+	Err  error
+}
+
+func (e *DayError) Error() string { return fmt.Sprintf("%s: %v", e.Date.Format("2006-01-02"), e.Err) }
+
+// Unwrap returns the underlying error.
+func (e *DayError) Unwrap() error { return e.Err }
+
+// DayErrors is returned by multi-day requests when one or more days fail.
+// It holds one DayError per failed day, in date order.
+type DayErrors []*DayError
+
+func (e DayErrors) Error() string {
+	msgs := make([]string, len(e))
+	for idx := range e {
+		msgs[idx] = e[idx].Error()
+	}
+	return fmt.Sprintf("%d day(s) failed: %s", len(e), strings.Join(msgs, "; "))
+}
@@ -0,0 +1,94 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+// +build !integration
+
+package rest_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("PlanHistorical", func() {
+	var s *Stock
+	var today time.Time
+	BeforeEach(func() {
+		s = NewStock(client)
+		today = time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	})
+
+	date := func(month time.Month, d int) time.Time { return time.Date(2021, month, d, 0, 0, 0, 0, time.UTC) }
+
+	It("should use the smallest range covering the interval", func() {
+		plan, err := s.PlanHistorical(ctx, date(time.June, 25), today, today)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plan).To(Equal(HistoricalPlan{
+			From: date(time.June, 25), To: today, Period: HistoricalPeriod5d, Last: 5, Credits: 50,
+		}))
+	})
+	It("should limit a larger range with chartLast", func() {
+		plan, err := s.PlanHistorical(ctx, date(time.March, 1), date(time.June, 30), today)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plan).To(Equal(HistoricalPlan{
+			From: date(time.March, 1), To: date(time.June, 30), Period: HistoricalPeriod6m, Last: 87, Credits: 870,
+		}))
+	})
+	It("should use exact dates when they cost fewer credits", func() {
+		plan, err := s.PlanHistorical(ctx, date(time.June, 4), date(time.June, 8), today)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plan).To(Equal(HistoricalPlan{
+			From:       date(time.June, 4),
+			To:         date(time.June, 8),
+			ExactDates: []time.Time{date(time.June, 4), date(time.June, 7), date(time.June, 8)},
+			Credits:    30,
+		}))
+	})
+	It("should count trading days with the Stock's Calendar", func() {
+		s.SetCalendar(closedCalendar{RuleCalendar{}, date(time.June, 7)})
+		plan, err := s.PlanHistorical(ctx, date(time.June, 4), date(time.June, 8), today)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plan.ExactDates).To(Equal([]time.Time{date(time.June, 4), date(time.June, 8)}))
+		Expect(plan.Credits).To(Equal(20))
+	})
+	It("should not use more than MaxExactDateCalls exact dates", func() {
+		plan, err := s.PlanHistorical(ctx, date(time.January, 4), date(time.February, 26), today)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plan.Period).To(Equal(HistoricalPeriod6m))
+		Expect(plan.ExactDates).To(BeEmpty())
+	})
+	It("should not plan past today", func() {
+		plan, err := s.PlanHistorical(ctx, date(time.June, 25), date(time.December, 31), today)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plan.Last).To(Equal(5))
+		Expect(plan.To).To(Equal(today))
+	})
+	It("should return an error if from is after to", func() {
+		_, err := s.PlanHistorical(ctx, date(time.June, 30), date(time.June, 1), today)
+		Expect(err).To(MatchError("from (2021-06-30) is after to (2021-06-01)"))
+	})
+	It("should return an error if from is missing", func() {
+		_, err := s.PlanHistorical(ctx, time.Time{}, today, today)
+		Expect(err).To(MatchError("from is missing"))
+	})
+	It("should return an error if from is before the earliest available data", func() {
+		_, err := s.PlanHistorical(ctx, time.Date(2001, time.January, 2, 0, 0, 0, 0, time.UTC), today, today)
+		Expect(err).To(MatchError("from (2001-01-02) is before the earliest available data"))
+	})
+})
@@ -0,0 +1,71 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/onwsk8r/goiex/pkg/core/crypto"
+)
+
+// Crypto exposes methods for accessing cryptocurrency data.
+// A list of endpoints can be found at https://iexcloud.io/docs/api/#cryptocurrency.
+type Crypto struct {
+	client *resty.Client
+}
+
+// NewCrypto creates a new Crypto with the given client
+func NewCrypto(client *resty.Client) *Crypto {
+	return &Crypto{
+		client: client,
+	}
+}
+
+// Quote returns the latest quote for a cryptocurrency, such as "BTCUSD".
+// https://iexcloud.io/docs/api/#cryptocurrency-quote
+func (c *Crypto) Quote(ctx context.Context, symbol string) (res *crypto.Quote, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = c.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/crypto/{symbol}/quote")
+	return
+}
+
+// Price returns the latest price of a cryptocurrency.
+// https://iexcloud.io/docs/api/#cryptocurrency-price
+func (c *Crypto) Price(ctx context.Context, symbol string) (res *crypto.Price, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = c.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/crypto/{symbol}/price")
+	return
+}
+
+// Book returns the current bids and asks for a cryptocurrency.
+// https://iexcloud.io/docs/api/#cryptocurrency-book
+func (c *Crypto) Book(ctx context.Context, symbol string) (res *crypto.Book, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = c.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/crypto/{symbol}/book")
+	return
+}
+
+// Symbols returns the cryptocurrencies supported by IEX Cloud.
+// https://iexcloud.io/docs/api/#cryptocurrency-symbols
+func (c *Crypto) Symbols(ctx context.Context) (res []crypto.Symbol, err error) {
+	_, err = c.client.R().SetContext(ctx).SetResult(&res).Get("/{version}/ref-data/crypto/symbols")
+	return
+}
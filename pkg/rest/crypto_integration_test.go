@@ -0,0 +1,71 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+// +build integration
+
+package rest_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("Crypto", func() {
+	var c *Crypto
+
+	BeforeEach(func() {
+		c = NewCrypto(client)
+		Expect(c).ToNot(BeNil())
+	})
+
+	Describe("Quote", func() {
+		It("should successfully get and parse a quote", func() {
+			res, err := c.Quote(ctx, "BTCUSD")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("Price", func() {
+		It("should successfully get and parse a price", func() {
+			res, err := c.Price(ctx, "BTCUSD")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("Book", func() {
+		It("should successfully get and parse a book", func() {
+			res, err := c.Book(ctx, "BTCUSD")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("Symbols", func() {
+		It("should successfully get and parse the crypto symbols", func() {
+			res, err := c.Symbols(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).ToNot(BeEmpty())
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+})
@@ -0,0 +1,46 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onwsk8r/goiex/pkg/core/crypto"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("Crypto", func() {
+	var c *Crypto
+
+	BeforeEach(func() {
+		c = NewCrypto(client)
+		Expect(c).ToNot(BeNil())
+	})
+
+	Describe("Quote", GetAndVerify("/v1/crypto/BTCUSD/quote", crypto.GoldenQuote(),
+		func() (interface{}, error) { return c.Quote(ctx, "BTCUSD") }))
+
+	Describe("Price", GetAndVerify("/v1/crypto/BTCUSD/price", crypto.GoldenPrice(),
+		func() (interface{}, error) { return c.Price(ctx, "BTCUSD") }))
+
+	Describe("Book", GetAndVerify("/v1/crypto/BTCUSD/book", crypto.GoldenBook(),
+		func() (interface{}, error) { return c.Book(ctx, "BTCUSD") }))
+
+	Describe("Symbols", GetAndVerify("/v1/ref-data/crypto/symbols", crypto.GoldenSymbol(),
+		func() (interface{}, error) { return c.Symbols(ctx) }))
+})
@@ -0,0 +1,135 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onwsk8r/goiex/pkg/core/fx"
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+)
+
+// DefaultFXLookbackDays is the lookback of a new CurrencyConverter; see SetLookback.
+const DefaultFXLookbackDays = 7
+
+// CurrencyConverter restates amounts into a base currency at the daily FX rates.
+// Rates are cached by currency and date, so a CurrencyConverter should be reused.
+// It is safe for concurrent use.
+type CurrencyConverter struct {
+	fx       *FX
+	base     string
+	lookback int
+	mu       sync.Mutex
+	rates    map[string]float64
+}
+
+// NewCurrencyConverter creates a CurrencyConverter into the base currency, such as "USD",
+// that fetches rates with the given FX.
+func NewCurrencyConverter(f *FX, base string) *CurrencyConverter {
+	return &CurrencyConverter{
+		fx:       f,
+		base:     strings.ToUpper(base),
+		lookback: DefaultFXLookbackDays,
+		rates:    make(map[string]float64),
+	}
+}
+
+// SetLookback sets the number of days before a date that Rate searches for a rate,
+// since there are no rates for weekends and some holidays. It is not synchronized, so
+// call it before the CurrencyConverter is shared.
+func (c *CurrencyConverter) SetLookback(days int) *CurrencyConverter {
+	c.lookback = days
+	return c
+}
+
+// Base returns the currency amounts are converted into.
+func (c *CurrencyConverter) Base() string {
+	return c.base
+}
+
+// Rate returns the number of units of the base currency per unit of the given currency
+// on the date of t. If there is no rate for that date, the most recent rate in the
+// lookback window before it is used. The lock is not held while fetching, so concurrent
+// calls that miss the cache for the same rate may each fetch it.
+// It will return an error if the rate cannot be fetched or none is found.
+func (c *CurrencyConverter) Rate(ctx context.Context, currency string, t time.Time) (float64, error) {
+	pair := fx.NewPair(currency, c.base)
+	if pair.FromCurrency == pair.ToCurrency {
+		return 1, nil
+	}
+	d := day(t)
+	key := pair.Symbol + d.Format("2006-01-02")
+
+	c.mu.Lock()
+	rate, ok := c.rates[key]
+	c.mu.Unlock()
+	if ok {
+		return rate, nil
+	}
+
+	opts := &FXHistoricalOptions{From: d.AddDate(0, 0, -c.lookback), To: d}
+	rates, err := c.fx.Historical(ctx, opts, pair.Symbol)
+	if err != nil {
+		return 0, fmt.Errorf("could not get the %s rate: %w", pair, err)
+	}
+	var latest *fx.HistoricalRate
+	for idx := range rates {
+		r := &rates[idx]
+		if r.Symbol == pair.Symbol && r.Rate > 0 && !r.Date.After(d) && (latest == nil || r.Date.After(latest.Date)) {
+			latest = r
+		}
+	}
+	if latest == nil {
+		return 0, fmt.Errorf("no %s rate within %d days of %s", pair, c.lookback, d.Format("2006-01-02"))
+	}
+	c.mu.Lock()
+	c.rates[key] = latest.Rate
+	c.mu.Unlock()
+	return latest.Rate, nil
+}
+
+// Convert restates an amount in the given currency into the base currency on the date of t.
+func (c *CurrencyConverter) Convert(ctx context.Context, amount float64, currency string,
+	t time.Time) (float64, error) {
+	rate, err := c.Rate(ctx, currency, t)
+	if err != nil {
+		return 0, err
+	}
+	return amount * rate, nil
+}
+
+// ConvertDividend returns a copy of the dividend with its Amount restated into the base
+// currency at the rate on its PaymentDate, and its Currency set to the base currency.
+// It will return an error if the dividend has no Currency or PaymentDate.
+func (c *CurrencyConverter) ConvertDividend(ctx context.Context, d *stock.Dividend) (res stock.Dividend, err error) {
+	switch {
+	case d.Currency == "":
+		return res, fmt.Errorf("dividend currency is missing")
+	case d.PaymentDate.IsZero():
+		return res, fmt.Errorf("dividend payment date is missing")
+	}
+	res = *d
+	if res.Amount, err = c.Convert(ctx, d.Amount, d.Currency, d.PaymentDate); err != nil {
+		return stock.Dividend{}, err
+	}
+	res.Currency = c.base
+	return res, nil
+}
@@ -0,0 +1,98 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("CurrencyConverter", func() {
+	var c *CurrencyConverter
+	var dividend *stock.Dividend
+	// The payment date is a Saturday, so the Friday rate is used.
+	payment := time.Date(2021, time.July, 10, 0, 0, 0, 0, time.UTC)
+	url := "/v1/fx/historical?from=2021-07-03&symbols=CADUSD&to=2021-07-10&token=sk_sometoken"
+
+	BeforeEach(func() {
+		c = NewCurrencyConverter(NewFX(client), "usd")
+		dividend = &stock.Dividend{Symbol: "ENB", Amount: 0.835, Currency: "CAD", PaymentDate: payment}
+		rates := [][]map[string]interface{}{{
+			{"date": "2021-07-08", "symbol": "CADUSD", "rate": 0.80013},
+			{"date": "2021-07-09", "symbol": "CADUSD", "rate": 0.8},
+			{"date": "2021-07-07", "symbol": "CADUSD", "rate": 0.80192},
+		}}
+		httpmock.RegisterResponder("GET", url, httpmock.NewJsonResponderOrPanic(http.StatusOK, rates))
+	})
+
+	It("should restate a dividend in the base currency", func() {
+		res, err := c.ConvertDividend(ctx, dividend)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Amount).To(BeNumerically("~", 0.668, 1e-9))
+		Expect(res.Currency).To(Equal("USD"))
+		Expect(res.Symbol).To(Equal("ENB"))
+		Expect(dividend.Amount).To(Equal(0.835))
+		Expect(c.Base()).To(Equal("USD"))
+	})
+	It("should cache the rates", func() {
+		_, err := c.ConvertDividend(ctx, dividend)
+		Expect(err).ToNot(HaveOccurred())
+		rate, err := c.Rate(ctx, "cad", payment.Add(12*time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rate).To(Equal(0.8))
+		Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+	})
+	It("should not make a request for the base currency", func() {
+		dividend.Currency = "USD"
+		res, err := c.ConvertDividend(ctx, dividend)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Amount).To(Equal(0.835))
+		Expect(httpmock.GetTotalCallCount()).To(BeZero())
+	})
+	It("should return an error if the dividend cannot be converted", func() {
+		dividend.Currency = ""
+		_, err := c.ConvertDividend(ctx, dividend)
+		Expect(err).To(MatchError("dividend currency is missing"))
+		dividend.Currency, dividend.PaymentDate = "CAD", time.Time{}
+		_, err = c.ConvertDividend(ctx, dividend)
+		Expect(err).To(MatchError("dividend payment date is missing"))
+	})
+	It("should return an error if there is no rate", func() {
+		httpmock.RegisterResponder("GET", url, httpmock.NewJsonResponderOrPanic(http.StatusOK, [][]string{{}}))
+		_, err := c.Convert(ctx, 1, "CAD", payment)
+		Expect(err).To(MatchError("no CADUSD rate within 7 days of 2021-07-10"))
+	})
+	It("should search the lookback window for a rate", func() {
+		httpmock.RegisterResponder("GET",
+			"/v1/fx/historical?from=2021-07-07&symbols=CADUSD&to=2021-07-10&token=sk_sometoken",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, [][]string{{}}))
+		_, err := c.SetLookback(3).Convert(ctx, 1, "CAD", payment)
+		Expect(err).To(MatchError("no CADUSD rate within 3 days of 2021-07-10"))
+	})
+	It("should return an error if the rates cannot be fetched", func() {
+		httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+		_, err := c.Convert(ctx, 1, "CAD", payment)
+		Expect(err).To(HaveOccurred())
+	})
+})
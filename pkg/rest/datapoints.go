@@ -0,0 +1,72 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/onwsk8r/goiex/pkg/core/datapoint"
+)
+
+// DataPoints exposes methods for accessing single fields of IEX datasets by key.
+// Data points cost far fewer credits than the endpoints they come from, and some
+// fields can only be reached this way. Use "market" as the symbol for market-wide keys.
+// https://iexcloud.io/docs/api/#data-points
+type DataPoints struct {
+	client *resty.Client
+}
+
+// NewDataPoints creates a new DataPoints with the given client
+func NewDataPoints(client *resty.Client) *DataPoints {
+	return &DataPoints{
+		client: client,
+	}
+}
+
+// List returns the data points available for a symbol.
+// https://iexcloud.io/docs/api/#data-points
+func (d *DataPoints) List(ctx context.Context, symbol string) (res []datapoint.Metadata, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = d.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/data-points/{symbol}")
+	return
+}
+
+// Get returns the value of a symbol's data point. Use the Value method that matches
+// the data point, e.g. Float64 for "QUOTE-LATESTPRICE". The value is returned as plain
+// text, so a response that is not valid JSON, such as an unquoted name or date, is kept
+// as a string. An empty response is null.
+// https://iexcloud.io/docs/api/#data-points
+func (d *DataPoints) Get(ctx context.Context, symbol, key string) (res datapoint.Value, err error) {
+	var params = map[string]string{"symbol": symbol, "key": key}
+	var resp *resty.Response
+	if resp, err = d.client.R().SetContext(ctx).SetPathParams(params).
+		Get("/{version}/data-points/{symbol}/{key}"); err != nil {
+		return
+	}
+	switch body := bytes.TrimSpace(resp.Body()); {
+	case len(body) == 0:
+	case json.Valid(body):
+		err = res.UnmarshalJSON(body)
+	default:
+		res = datapoint.NewStringValue(string(body))
+	}
+	return
+}
@@ -0,0 +1,57 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+// +build integration
+
+package rest_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("DataPoints", func() {
+	var d *DataPoints
+
+	BeforeEach(func() {
+		d = NewDataPoints(client)
+		Expect(d).ToNot(BeNil())
+	})
+
+	Describe("List", func() {
+		It("should successfully get and parse the data points of a symbol", func() {
+			res, err := d.List(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).ToNot(BeEmpty())
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("Get", func() {
+		It("should successfully get a numeric data point", func() {
+			res, err := d.Get(ctx, "AAPL", "QUOTE-LATESTPRICE")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			price, err := res.Float64()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(price).To(BeNumerically(">", 0))
+		})
+	})
+})
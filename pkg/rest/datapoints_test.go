@@ -0,0 +1,90 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest_test
+
+import (
+	"net/http"
+
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onwsk8r/goiex/pkg/core/datapoint"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("DataPoints", func() {
+	var d *DataPoints
+
+	BeforeEach(func() {
+		d = NewDataPoints(client)
+		Expect(d).ToNot(BeNil())
+	})
+
+	Describe("List", GetAndVerify("/v1/data-points/AAPL", datapoint.GoldenMetadata(),
+		func() (interface{}, error) { return d.List(ctx, "AAPL") }))
+
+	Describe("Get", func() {
+		get := func(body string) (datapoint.Value, error) {
+			httpmock.RegisterResponder("GET", "/v1/data-points/AAPL/SOMEKEY",
+				httpmock.NewStringResponder(http.StatusOK, body))
+			return d.Get(ctx, "AAPL", "SOMEKEY")
+		}
+
+		It("should return a number", func() {
+			res, err := get("144.57")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Float64()).To(Equal(144.57))
+		})
+		It("should return a string", func() {
+			res, err := get(`"Apple Inc."`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.String()).To(Equal("Apple Inc."))
+		})
+		It("should return a plain text string", func() {
+			res, err := get("Apple Inc.")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Kind()).To(Equal(datapoint.KindString))
+			Expect(res.String()).To(Equal("Apple Inc."))
+		})
+		It("should return a plain text date", func() {
+			res, err := get("2021-08-12\n")
+			Expect(err).ToNot(HaveOccurred())
+			t, err := res.Time()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(t.Format("2006-01-02")).To(Equal("2021-08-12"))
+		})
+		It("should return a date", func() {
+			res, err := get(`"2021-08-12"`)
+			Expect(err).ToNot(HaveOccurred())
+			t, err := res.Time()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(t.Format("2006-01-02")).To(Equal("2021-08-12"))
+		})
+		It("should return null for an empty response", func() {
+			res, err := get("")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.IsNull()).To(BeTrue())
+		})
+		It("should return an error if the request fails", func() {
+			httpmock.RegisterResponder("GET", "/v1/data-points/AAPL/NOPE",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			_, err := d.Get(ctx, "AAPL", "NOPE")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,128 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+)
+
+// EarningsPeriod is the reporting period of earnings and estimates.
+type EarningsPeriod string
+
+var (
+	EarningsPeriodQuarter EarningsPeriod = "quarter"
+	EarningsPeriodAnnual  EarningsPeriod = "annual"
+)
+
+// EarningsOptions holds the optional parameters accepted by Stock.Earnings and Stock.Estimates.
+// The zero value of each field leaves the corresponding parameter unset so the API default applies.
+// https://iexcloud.io/docs/api/#earnings
+type EarningsOptions struct {
+	// Last is the number of periods to return.
+	Last int
+	// Period is EarningsPeriodQuarter (the default) or EarningsPeriodAnnual.
+	Period EarningsPeriod
+	// Field requests a single field, by its JSON name (eg "actualEPS"). Earnings only.
+	Field string
+}
+
+// validate returns an error if the options cannot be sent.
+func (o *EarningsOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	switch {
+	case o.Last < 0:
+		return fmt.Errorf("earnings options: last must not be negative")
+	case o.Period != "" && o.Period != EarningsPeriodQuarter && o.Period != EarningsPeriodAnnual:
+		return fmt.Errorf("earnings options: invalid period %q", o.Period)
+	case strings.ContainsAny(o.Field, "/?#"):
+		return fmt.Errorf("earnings options: invalid field %q", o.Field)
+	}
+	return nil
+}
+
+// query returns the query string parameters for the options. When a Field is set,
+// Last is sent as a path parameter instead.
+func (o *EarningsOptions) query() map[string]string {
+	params := make(map[string]string)
+	if o == nil {
+		return params
+	}
+	if o.Last > 0 && o.Field == "" {
+		params["last"] = strconv.Itoa(o.Last)
+	}
+	if o.Period != "" {
+		params["period"] = string(o.Period)
+	}
+	return params
+}
+
+// path returns the earnings endpoint path, adding the last and field path parameters
+// when a Field is set.
+func (o *EarningsOptions) path(pathParams map[string]string) string {
+	if o == nil || o.Field == "" {
+		return "/{version}/stock/{symbol}/earnings"
+	}
+	last := 1
+	if o.Last > 0 {
+		last = o.Last
+	}
+	pathParams["last"] = strconv.Itoa(last)
+	pathParams["field"] = o.Field
+	return "/{version}/stock/{symbol}/earnings/{last}/{field}"
+}
+
+// decodeEarningsField decodes the response to a single field request. IEX returns the
+// usual response when the field is unknown, a bare value for a single period, and an
+// array of values for several periods. Each value is set on its own Earning.
+func decodeEarningsField(data []byte, field string) (res []stock.Earning, err error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	if data[0] == '{' {
+		var wrapped struct {
+			Earnings []stock.Earning `json:"earnings"`
+		}
+		err = json.Unmarshal(data, &wrapped)
+		return wrapped.Earnings, err
+	}
+
+	var values []json.RawMessage
+	if data[0] == '[' {
+		if err = json.Unmarshal(data, &values); err != nil {
+			return
+		}
+	} else {
+		values = []json.RawMessage{data}
+	}
+	res = make([]stock.Earning, len(values))
+	for idx := range values {
+		obj, _ := json.Marshal(map[string]json.RawMessage{field: values[idx]}) // nolint:errcheck
+		if err = json.Unmarshal(obj, &res[idx]); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
@@ -0,0 +1,70 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/onwsk8r/goiex/pkg/core/market"
+)
+
+// Economic exposes methods for accessing economic and commodity data, such as treasury
+// rates, CPI, and oil prices. Series are identified by their key, and the known keys are
+// cataloged in market.EconomicIndicators.
+// https://iexcloud.io/docs/api/#economic-data
+type Economic struct {
+	client *resty.Client
+}
+
+// NewEconomic creates a new Economic with the given client
+func NewEconomic(client *resty.Client) *Economic {
+	return &Economic{
+		client: client,
+	}
+}
+
+// Latest returns the latest value of the series with the given key, such as "DGS10".
+// It is read with DataPoints.Get, since the value is returned as plain text.
+// It will return an error if the value is not a number.
+// https://iexcloud.io/docs/api/#data-points
+func (e *Economic) Latest(ctx context.Context, key string) (float64, error) {
+	value, err := NewDataPoints(e.client).Get(ctx, "market", key)
+	if err != nil {
+		return 0, err
+	}
+	return value.Float64()
+}
+
+// Series returns the history of the series with the given key, with the points dated
+// between from and to, inclusive. A zero from or to leaves that end of the range open,
+// and IEX only returns the latest point if both are zero.
+// It will return an error if the key is not in market.EconomicIndicators.
+// https://iexcloud.io/docs/api/#time-series
+func (e *Economic) Series(ctx context.Context, key string, from, to time.Time) (*market.EconomicSeries, error) {
+	indicator, ok := market.EconomicIndicators[key]
+	if !ok {
+		return nil, fmt.Errorf("unknown economic series %q", key)
+	}
+	var points []market.EconomicPoint
+	if err := timeSeries(ctx, e.client, indicator.Dataset, key, from, to, &points); err != nil {
+		return nil, err
+	}
+	return market.NewEconomicSeries(indicator, points), nil
+}
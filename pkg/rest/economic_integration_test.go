@@ -0,0 +1,54 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+// +build integration
+
+package rest_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("Economic", func() {
+	var e *Economic
+
+	BeforeEach(func() {
+		e = NewEconomic(client)
+		Expect(e).ToNot(BeNil())
+	})
+
+	Describe("Latest", func() {
+		It("should successfully get the latest value", func() {
+			res, err := e.Latest(ctx, "DGS10")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(BeNumerically(">", 0))
+		})
+	})
+
+	Describe("Series", func() {
+		It("should successfully get and parse a series", func() {
+			res, err := e.Series(ctx, "DCOILWTICO", time.Now().AddDate(0, -1, 0), time.Now())
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Points).ToNot(BeEmpty())
+			Expect(res.Validate()).To(Succeed())
+		})
+	})
+})
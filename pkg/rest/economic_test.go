@@ -0,0 +1,86 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onwsk8r/goiex/pkg/core/market"
+	"github.com/onwsk8r/goiex/test/helper"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("Economic", func() {
+	var e *Economic
+
+	BeforeEach(func() {
+		e = NewEconomic(client)
+		Expect(e).ToNot(BeNil())
+	})
+
+	Describe("Latest", func() {
+		respond := func(body string) {
+			httpmock.RegisterResponder("GET", "/v1/data-points/market/DGS10", func(*http.Request) (*http.Response, error) {
+				resp := httpmock.NewStringResponse(http.StatusOK, body)
+				resp.Header.Set("Content-Type", "text/plain")
+				return resp, nil
+			})
+		}
+
+		It("should read the plain text value", func() {
+			respond("1.32")
+			res, err := e.Latest(ctx, "DGS10")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(1.32))
+			Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+		})
+		It("should return an error if the value is not a number", func() {
+			respond("")
+			_, err := e.Latest(ctx, "DGS10")
+			Expect(err).To(MatchError("data point null is not a number"))
+		})
+		It("should return an error if the request fails", func() {
+			httpmock.RegisterResponder("GET", "/v1/data-points/market/NOPE",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			_, err := e.Latest(ctx, "NOPE")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Series", func() {
+		It("should get the series from its dataset", func() {
+			helper.TestdataResponder("/v1/time-series/treasury/DGS10?from=2021-07-06&to=2021-07-08&token=sk_sometoken",
+				"core/market/economic_series.json")
+			from := time.Date(2021, time.July, 6, 0, 0, 0, 0, time.UTC)
+			res, err := e.Series(ctx, "DGS10", from, from.AddDate(0, 0, 2))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.EconomicIndicator).To(Equal(market.EconomicIndicators["DGS10"]))
+			Expect(res.Points).To(HaveLen(3))
+			Expect(res.Points[0].Date).To(Equal(from))
+			Expect(res.Validate()).To(Succeed())
+		})
+		It("should return an error for an unknown key", func() {
+			_, err := e.Series(ctx, "NOPE", time.Time{}, time.Time{})
+			Expect(err).To(MatchError(`unknown economic series "NOPE"`))
+		})
+	})
+})
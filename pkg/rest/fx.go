@@ -0,0 +1,126 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/onwsk8r/goiex/pkg/core/fx"
+)
+
+// FX exposes methods for accessing foreign exchange rates. Currency pairs are given by
+// their symbol, such as "USDCAD", which fx.NewPair can build from two currency codes.
+// A list of endpoints can be found at https://iexcloud.io/docs/api/#forex-currencies.
+type FX struct {
+	client *resty.Client
+}
+
+// NewFX creates a new FX with the given client
+func NewFX(client *resty.Client) *FX {
+	return &FX{
+		client: client,
+	}
+}
+
+// Latest returns the latest rates for the given currency pairs.
+// https://iexcloud.io/docs/api/#latest-currency-rates
+func (f *FX) Latest(ctx context.Context, pairs ...string) (res []fx.Rate, err error) {
+	_, err = f.pairs(ctx, pairs).SetResult(&res).Get("/{version}/fx/latest")
+	return
+}
+
+// Convert converts an amount at the latest rates for the given currency pairs.
+// https://iexcloud.io/docs/api/#currency-conversion
+func (f *FX) Convert(ctx context.Context, amount float64, pairs ...string) (res []fx.Conversion, err error) {
+	_, err = f.pairs(ctx, pairs).SetQueryParam("amount", strconv.FormatFloat(amount, 'f', -1, 64)).
+		SetResult(&res).Get("/{version}/fx/convert")
+	return
+}
+
+// FXHistoricalOptions selects the dates returned by FX.Historical. The zero value
+// returns the most recent rates.
+type FXHistoricalOptions struct {
+	// From and To limit the rates to the dates between them, inclusive.
+	From, To time.Time
+	// On returns the rates for a single date. It cannot be used with From and To.
+	On time.Time
+	// Last is the number of most recent dates to return.
+	Last int
+}
+
+// validate returns an error if the options cannot be sent.
+func (o *FXHistoricalOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	switch {
+	case o.Last < 0:
+		return fmt.Errorf("fx historical options: last must not be negative")
+	case !o.On.IsZero() && (!o.From.IsZero() || !o.To.IsZero()):
+		return fmt.Errorf("fx historical options: on cannot be used with from or to")
+	case !o.From.IsZero() && !o.To.IsZero() && o.To.Before(o.From):
+		return fmt.Errorf("fx historical options: to is before from")
+	}
+	return nil
+}
+
+// query returns the query string parameters for the options.
+func (o *FXHistoricalOptions) query() map[string]string {
+	params := make(map[string]string)
+	if o == nil {
+		return params
+	}
+	if !o.From.IsZero() {
+		params["from"] = o.From.Format("2006-01-02")
+	}
+	if !o.To.IsZero() {
+		params["to"] = o.To.Format("2006-01-02")
+	}
+	if !o.On.IsZero() {
+		params["on"] = o.On.Format("2006-01-02")
+	}
+	if o.Last > 0 {
+		params["last"] = strconv.Itoa(o.Last)
+	}
+	return params
+}
+
+// Historical returns daily rates for the given currency pairs. IEX returns one list
+// of rates per pair, which are concatenated in the order of the pairs.
+// https://iexcloud.io/docs/api/#historical-daily
+func (f *FX) Historical(ctx context.Context, opts *FXHistoricalOptions,
+	pairs ...string) (res []fx.HistoricalRate, err error) {
+	if err = opts.validate(); err != nil {
+		return
+	}
+	var lists [][]fx.HistoricalRate
+	_, err = f.pairs(ctx, pairs).SetQueryParams(opts.query()).SetResult(&lists).Get("/{version}/fx/historical")
+	for idx := range lists {
+		res = append(res, lists[idx]...)
+	}
+	return
+}
+
+// pairs creates a request with the symbols parameter set to the given currency pairs.
+func (f *FX) pairs(ctx context.Context, pairs []string) *resty.Request {
+	return f.client.R().SetContext(ctx).SetQueryParam("symbols", strings.Join(pairs, ","))
+}
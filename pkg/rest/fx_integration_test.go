@@ -0,0 +1,78 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+// +build integration
+
+package rest_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("FX", func() {
+	var f *FX
+
+	BeforeEach(func() {
+		f = NewFX(client)
+		Expect(f).ToNot(BeNil())
+	})
+
+	Describe("Latest", func() {
+		It("should successfully get and parse the latest rates", func() {
+			res, err := f.Latest(ctx, "USDCAD", "EURUSD")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(HaveLen(2))
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("Convert", func() {
+		It("should successfully get and parse a conversion", func() {
+			res, err := f.Convert(ctx, 100, "USDCAD")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(HaveLen(1))
+			Expect(res[0].Validate()).To(Succeed())
+		})
+	})
+
+	Describe("Historical", func() {
+		It("should successfully get and parse historical rates", func() {
+			opts := &FXHistoricalOptions{From: time.Now().AddDate(0, 0, -14), To: time.Now()}
+			res, err := f.Historical(ctx, opts, "USDCAD", "EURUSD")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).ToNot(BeEmpty())
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("CurrencyConverter", func() {
+		It("should convert at a historical rate", func() {
+			c := NewCurrencyConverter(f, "USD")
+			rate, err := c.Rate(ctx, "EUR", time.Now().AddDate(0, 0, -7))
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(rate).To(BeNumerically(">", 0))
+		})
+	})
+})
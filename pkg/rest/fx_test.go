@@ -0,0 +1,73 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onwsk8r/goiex/pkg/core/fx"
+	"github.com/onwsk8r/goiex/test/helper"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("FX", func() {
+	var f *FX
+
+	BeforeEach(func() {
+		f = NewFX(client)
+		Expect(f).ToNot(BeNil())
+	})
+
+	Describe("Latest", GetAndVerify("/v1/fx/latest?symbols=USDCAD%2CCADUSD&token=sk_sometoken", fx.GoldenRate(),
+		func() (interface{}, error) { return f.Latest(ctx, "USDCAD", "CADUSD") }))
+
+	Describe("Convert", GetAndVerify("/v1/fx/convert?amount=100&symbols=USDCAD&token=sk_sometoken",
+		fx.GoldenConversion(), func() (interface{}, error) { return f.Convert(ctx, 100, "USDCAD") }))
+
+	Describe("Historical", func() {
+		It("should concatenate the rates of each pair", func() {
+			helper.TestdataResponder("/v1/fx/historical?from=2021-07-07&symbols=CADUSD%2CEURUSD"+
+				"&to=2021-07-08&token=sk_sometoken", "core/fx/historical_rate.json")
+			opts := &FXHistoricalOptions{
+				From: time.Date(2021, time.July, 7, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2021, time.July, 8, 0, 0, 0, 0, time.UTC),
+			}
+			res, err := f.Historical(ctx, opts, "CADUSD", "EURUSD")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(fx.GoldenHistoricalRate()))
+		})
+		It("should send the on and last parameters", func() {
+			helper.TestdataResponder("/v1/fx/historical?last=2&on=2021-07-08&symbols=CADUSD&token=sk_sometoken",
+				"core/fx/historical_rate.json")
+			opts := &FXHistoricalOptions{On: time.Date(2021, time.July, 8, 0, 0, 0, 0, time.UTC), Last: 2}
+			_, err := f.Historical(ctx, opts, "CADUSD")
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("should not send invalid options", func() {
+			day := time.Date(2021, time.July, 8, 0, 0, 0, 0, time.UTC)
+			_, err := f.Historical(ctx, &FXHistoricalOptions{Last: -1}, "CADUSD")
+			Expect(err).To(MatchError("fx historical options: last must not be negative"))
+			_, err = f.Historical(ctx, &FXHistoricalOptions{On: day, From: day}, "CADUSD")
+			Expect(err).To(MatchError("fx historical options: on cannot be used with from or to"))
+			_, err = f.Historical(ctx, &FXHistoricalOptions{From: day, To: day.AddDate(0, 0, -1)}, "CADUSD")
+			Expect(err).To(MatchError("fx historical options: to is before from"))
+		})
+	})
+})
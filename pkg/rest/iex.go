@@ -0,0 +1,140 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/onwsk8r/goiex/pkg/core/iex"
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+)
+
+// IEX exposes methods for accessing the IEX exchange's own market data.
+// A list of endpoints can be found at https://iexcloud.io/docs/api/#iex-market-data.
+type IEX struct {
+	client *resty.Client
+}
+
+// NewIEX creates a new IEX with the given client
+func NewIEX(client *resty.Client) *IEX {
+	return &IEX{
+		client: client,
+	}
+}
+
+// TOPS returns the IEX top of book quote and last sale for the given symbols,
+// or for every symbol traded on IEX if none are given.
+// https://iexcloud.io/docs/api/#tops
+func (i *IEX) TOPS(ctx context.Context, symbols ...string) (res []iex.TOPS, err error) {
+	_, err = i.symbols(ctx, symbols).SetResult(&res).Get("/{version}/tops")
+	return
+}
+
+// Last returns the last sale on IEX for the given symbols, or for every symbol
+// traded on IEX if none are given.
+// https://iexcloud.io/docs/api/#last
+func (i *IEX) Last(ctx context.Context, symbols ...string) (res []iex.Last, err error) {
+	_, err = i.symbols(ctx, symbols).SetResult(&res).Get("/{version}/tops/last")
+	return
+}
+
+// DEEP returns the aggregated IEX book, the latest trades, and the status of a symbol.
+// https://iexcloud.io/docs/api/#deep
+func (i *IEX) DEEP(ctx context.Context, symbol string) (res *iex.DEEP, err error) {
+	_, err = i.symbols(ctx, []string{symbol}).SetResult(&res).Get("/{version}/deep")
+	return
+}
+
+// DEEPAuction returns the current auction information for the given symbols, keyed by symbol.
+// Symbols that are not in an auction are omitted.
+// https://iexcloud.io/docs/api/#deep-auction
+func (i *IEX) DEEPAuction(ctx context.Context, symbols ...string) (res map[string]iex.Auction, err error) {
+	_, err = i.symbols(ctx, symbols).SetResult(&res).Get("/{version}/deep/auction")
+	return
+}
+
+// DEEPBook returns the aggregated IEX bids and asks for the given symbols, keyed by symbol.
+// https://iexcloud.io/docs/api/#deep-book
+func (i *IEX) DEEPBook(ctx context.Context, symbols ...string) (res map[string]iex.Book, err error) {
+	_, err = i.symbols(ctx, symbols).SetResult(&res).Get("/{version}/deep/book")
+	return
+}
+
+// DEEPOfficialPrice returns the official IEX open and close prices for the given
+// symbols, keyed by symbol.
+// https://iexcloud.io/docs/api/#deep-official-price
+func (i *IEX) DEEPOfficialPrice(ctx context.Context,
+	symbols ...string) (res map[string]iex.OfficialPrice, err error) {
+	_, err = i.symbols(ctx, symbols).SetResult(&res).Get("/{version}/deep/official-price")
+	return
+}
+
+// DEEPOpHaltStatus returns the operational halt status of the given symbols, keyed by symbol.
+// https://iexcloud.io/docs/api/#deep-operational-halt-status
+func (i *IEX) DEEPOpHaltStatus(ctx context.Context,
+	symbols ...string) (res map[string]iex.OpHaltStatus, err error) {
+	_, err = i.symbols(ctx, symbols).SetResult(&res).Get("/{version}/deep/op-halt-status")
+	return
+}
+
+// DEEPSecurityEvent returns the latest security event of the given symbols, keyed by symbol.
+// https://iexcloud.io/docs/api/#deep-security-event
+func (i *IEX) DEEPSecurityEvent(ctx context.Context,
+	symbols ...string) (res map[string]iex.SecurityEvent, err error) {
+	_, err = i.symbols(ctx, symbols).SetResult(&res).Get("/{version}/deep/security-event")
+	return
+}
+
+// DEEPSSRStatus returns the short sale price test status of the given symbols, keyed by symbol.
+// https://iexcloud.io/docs/api/#deep-short-sale-price-test-status
+func (i *IEX) DEEPSSRStatus(ctx context.Context, symbols ...string) (res map[string]iex.SSRStatus, err error) {
+	_, err = i.symbols(ctx, symbols).SetResult(&res).Get("/{version}/deep/ssr-status")
+	return
+}
+
+// DEEPSystemEvent returns the latest system event, which applies to the whole exchange.
+// https://iexcloud.io/docs/api/#deep-system-event
+func (i *IEX) DEEPSystemEvent(ctx context.Context) (res *iex.SystemEvent, err error) {
+	_, err = i.client.R().SetContext(ctx).SetResult(&res).Get("/{version}/deep/system-event")
+	return
+}
+
+// DEEPTrades returns the latest IEX trades for the given symbols, keyed by symbol.
+// https://iexcloud.io/docs/api/#deep-trades
+func (i *IEX) DEEPTrades(ctx context.Context, symbols ...string) (res map[string][]stock.Trade, err error) {
+	_, err = i.symbols(ctx, symbols).SetResult(&res).Get("/{version}/deep/trades")
+	return
+}
+
+// DEEPTradingStatus returns the trading status of the given symbols, keyed by symbol.
+// https://iexcloud.io/docs/api/#deep-trading-status
+func (i *IEX) DEEPTradingStatus(ctx context.Context,
+	symbols ...string) (res map[string]iex.TradingStatus, err error) {
+	_, err = i.symbols(ctx, symbols).SetResult(&res).Get("/{version}/deep/trading-status")
+	return
+}
+
+// symbols returns a request with the symbols query string parameter set, unless symbols is empty.
+func (i *IEX) symbols(ctx context.Context, symbols []string) *resty.Request {
+	req := i.client.R().SetContext(ctx)
+	if len(symbols) > 0 {
+		req.SetQueryParam("symbols", strings.Join(symbols, ","))
+	}
+	return req
+}
@@ -0,0 +1,158 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+// +build integration
+
+package rest_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("IEX", func() {
+	var i *IEX
+
+	BeforeEach(func() {
+		i = NewIEX(client)
+		Expect(i).ToNot(BeNil())
+	})
+
+	Describe("TOPS", func() {
+		It("should successfully get and parse TOPS for a list of symbols", func() {
+			res, err := i.TOPS(ctx, "AAPL", "IBM")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(HaveLen(2))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+		It("should successfully get and parse TOPS for all symbols", func() {
+			res, err := i.TOPS(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically(">", 2500))
+		})
+	})
+
+	Describe("Last", func() {
+		It("should successfully get and parse Last for a list of symbols", func() {
+			res, err := i.Last(ctx, "AAPL", "IBM")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(HaveLen(2))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+	Describe("DEEP", func() {
+		It("should successfully get and parse DEEP", func() {
+			res, err := i.DEEP(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("DEEPBook", func() {
+		It("should successfully get and parse the book", func() {
+			res, err := i.DEEPBook(ctx, "AAPL", "IBM")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(HaveLen(2))
+		})
+	})
+
+	Describe("DEEPTrades", func() {
+		It("should successfully get and parse trades", func() {
+			res, err := i.DEEPTrades(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(HaveKey("AAPL"))
+		})
+	})
+
+	Describe("DEEPSystemEvent", func() {
+		It("should successfully get and parse the system event", func() {
+			res, err := i.DEEPSystemEvent(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("DEEPTradingStatus", func() {
+		It("should successfully get and parse trading statuses", func() {
+			res, err := i.DEEPTradingStatus(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+
+			for symbol := range res {
+				status := res[symbol]
+				Expect(status.Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("DEEPOpHaltStatus", func() {
+		It("should successfully get and parse operational halt statuses", func() {
+			_, err := i.DEEPOpHaltStatus(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+		})
+	})
+
+	Describe("DEEPSSRStatus", func() {
+		It("should successfully get and parse short sale price test statuses", func() {
+			res, err := i.DEEPSSRStatus(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+
+			for symbol := range res {
+				status := res[symbol]
+				Expect(status.Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("DEEPSecurityEvent", func() {
+		It("should successfully get and parse security events", func() {
+			_, err := i.DEEPSecurityEvent(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+		})
+	})
+
+	Describe("DEEPAuction", func() {
+		It("should successfully get and parse auctions", func() {
+			res, err := i.DEEPAuction(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+
+			for symbol := range res {
+				auction := res[symbol]
+				Expect(auction.Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("DEEPOfficialPrice", func() {
+		It("should successfully get and parse official prices", func() {
+			res, err := i.DEEPOfficialPrice(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+
+			for symbol := range res {
+				price := res[symbol]
+				Expect(price.Validate()).To(Succeed())
+			}
+		})
+	})
+})
@@ -0,0 +1,86 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+// +build !integration
+
+package rest_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onwsk8r/goiex/pkg/core/iex"
+	"github.com/onwsk8r/goiex/pkg/core/stock"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("IEX", func() {
+	var i *IEX
+	deep := iex.GoldenDEEP()
+
+	BeforeEach(func() {
+		i = NewIEX(client)
+		Expect(i).ToNot(BeNil())
+	})
+
+	Describe("TOPS", func() {
+		Context("For all symbols", GetAndVerify("/v1/tops", iex.GoldenTOPS(),
+			func() (interface{}, error) { return i.TOPS(ctx) }))
+		Context("For a list of symbols", GetAndVerify("/v1/tops?symbols=AAPL%2CZXZZT&token=sk_sometoken",
+			iex.GoldenTOPS(), func() (interface{}, error) { return i.TOPS(ctx, "AAPL", "ZXZZT") }))
+	})
+
+	Describe("Last", func() {
+		Context("For all symbols", GetAndVerify("/v1/tops/last", iex.GoldenLast(),
+			func() (interface{}, error) { return i.Last(ctx) }))
+		Context("For a list of symbols", GetAndVerify("/v1/tops/last?symbols=AAPL%2CZXZZT&token=sk_sometoken",
+			iex.GoldenLast(), func() (interface{}, error) { return i.Last(ctx, "AAPL", "ZXZZT") }))
+	})
+	Describe("DEEP", GetAndVerify("/v1/deep?symbols=AAPL&token=sk_sometoken", iex.GoldenDEEP(),
+		func() (interface{}, error) { return i.DEEP(ctx, "AAPL") }))
+
+	Describe("DEEPAuction", GetAndVerify("/v1/deep/auction?symbols=ZIEXT&token=sk_sometoken",
+		iex.GoldenAuction(), func() (interface{}, error) { return i.DEEPAuction(ctx, "ZIEXT") }))
+
+	Describe("DEEPBook", GetAndVerify("/v1/deep/book?symbols=AAPL&token=sk_sometoken",
+		map[string]iex.Book{"AAPL": {Bids: deep.Bids, Asks: deep.Asks}},
+		func() (interface{}, error) { return i.DEEPBook(ctx, "AAPL") }))
+
+	Describe("DEEPOfficialPrice", GetAndVerify("/v1/deep/official-price?symbols=AAPL&token=sk_sometoken",
+		iex.GoldenOfficialPrice(), func() (interface{}, error) { return i.DEEPOfficialPrice(ctx, "AAPL") }))
+
+	Describe("DEEPOpHaltStatus", GetAndVerify("/v1/deep/op-halt-status?symbols=AAPL&token=sk_sometoken",
+		map[string]iex.OpHaltStatus{"AAPL": *deep.OpHaltStatus},
+		func() (interface{}, error) { return i.DEEPOpHaltStatus(ctx, "AAPL") }))
+
+	Describe("DEEPSecurityEvent", GetAndVerify("/v1/deep/security-event?symbols=AAPL&token=sk_sometoken",
+		map[string]iex.SecurityEvent{"AAPL": *deep.SecurityEvent},
+		func() (interface{}, error) { return i.DEEPSecurityEvent(ctx, "AAPL") }))
+
+	Describe("DEEPSSRStatus", GetAndVerify("/v1/deep/ssr-status?symbols=AAPL&token=sk_sometoken",
+		map[string]iex.SSRStatus{"AAPL": *deep.SSRStatus},
+		func() (interface{}, error) { return i.DEEPSSRStatus(ctx, "AAPL") }))
+
+	Describe("DEEPSystemEvent", GetAndVerify("/v1/deep/system-event", deep.SystemEvent,
+		func() (interface{}, error) { return i.DEEPSystemEvent(ctx) }))
+
+	Describe("DEEPTrades", GetAndVerify("/v1/deep/trades?symbols=AAPL&token=sk_sometoken",
+		map[string][]stock.Trade{"AAPL": deep.Trades},
+		func() (interface{}, error) { return i.DEEPTrades(ctx, "AAPL") }))
+
+	Describe("DEEPTradingStatus", GetAndVerify("/v1/deep/trading-status?symbols=AAPL&token=sk_sometoken",
+		map[string]iex.TradingStatus{"AAPL": *deep.TradingStatus},
+		func() (interface{}, error) { return i.DEEPTradingStatus(ctx, "AAPL") }))
+})
@@ -18,6 +18,8 @@ package rest
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/onwsk8r/goiex/pkg/core/market"
@@ -37,8 +39,77 @@ func NewMarket(client *resty.Client) *Market {
 	}
 }
 
-// UpcomingDividends fetches a list of upcoming earnings from the upcoming events endpoint.
-// Pass "market" as the symbol parameter to return data for all symbols.
+// ListType is the name of a market list.
+type ListType string
+
+var (
+	ListGainers    ListType = "gainers"
+	ListLosers     ListType = "losers"
+	ListMostActive ListType = "mostactive"
+	ListIEXVolume  ListType = "iexvolume"
+	ListIEXPercent ListType = "iexpercent"
+)
+
+// MaxListLimit is the largest ListOptions.Limit the list endpoint accepts.
+var MaxListLimit = 100
+
+// ListOptions holds the optional query string parameters accepted by Market.List.
+type ListOptions struct {
+	// DisplayPercent returns the percent fields multiplied by 100.
+	DisplayPercent bool
+	// Limit is the number of quotes to return, up to MaxListLimit. IEX returns 10 by default.
+	Limit int
+}
+
+// query returns the query string parameters for the options, or an error if they are invalid.
+func (o *ListOptions) query() (map[string]string, error) {
+	params := make(map[string]string)
+	if o == nil {
+		return params, nil
+	}
+	if o.Limit < 0 || o.Limit > MaxListLimit {
+		return nil, fmt.Errorf("list options: limit must be between 0 and %d", MaxListLimit)
+	}
+	if o.Limit > 0 {
+		params["listLimit"] = strconv.Itoa(o.Limit)
+	}
+	if o.DisplayPercent {
+		params["displayPercent"] = "true"
+	}
+	return params, nil
+}
+
+// List returns the quotes for the symbols in the given market list. The lists are
+// updated intraday, and each Quote has the same fields as the one in Stock.Book.
+// https://iexcloud.io/docs/api/#list
+func (m *Market) List(ctx context.Context, list ListType, opts *ListOptions) (res []stock.Quote, err error) {
+	var query map[string]string
+	if query, err = opts.query(); err != nil {
+		return
+	}
+	var params = map[string]string{"list": string(list)}
+	_, err = m.client.R().SetContext(ctx).SetPathParams(params).SetQueryParams(query).SetResult(&res).
+		Get("/{version}/stock/market/list/{list}")
+	return
+}
+
+// SectorPerformance returns the performance of each sector based on the sector ETFs.
+// https://iexcloud.io/docs/api/#sector-performance
+func (m *Market) SectorPerformance(ctx context.Context) (res []market.SectorPerformance, err error) {
+	_, err = m.client.R().SetContext(ctx).SetResult(&res).Get("/{version}/stock/market/sector-performance")
+	return
+}
+
+// TodayIPOs returns the IPOs expected to price today, updated at 10am, 10:30am and 8pm ET.
+// https://iexcloud.io/docs/api/#ipo-calendar
+func (m *Market) TodayIPOs(ctx context.Context) (res []market.IPO, err error) {
+	var ipos market.IPOs
+	_, err = m.client.R().SetContext(ctx).SetResult(&ipos).Get("/{version}/stock/market/today-ipos")
+	return ipos, err
+}
+
+// UpcomingDividends fetches a list of upcoming dividends from the upcoming events endpoint.
+// Use UpcomingDividendsMarket for the whole market.
 // https://iexcloud.io/docs/api/#upcoming-events
 func (m *Market) UpcomingDividends(ctx context.Context,
 	symbol string) (dividends []market.UpcomingDividend, err error) {
@@ -48,8 +119,15 @@ func (m *Market) UpcomingDividends(ctx context.Context,
 	return
 }
 
+// UpcomingDividendsMarket fetches a list of upcoming dividends for the whole market.
+// https://iexcloud.io/docs/api/#upcoming-events
+func (m *Market) UpcomingDividendsMarket(ctx context.Context) (dividends []market.UpcomingDividend, err error) {
+	_, err = m.client.R().SetContext(ctx).SetResult(&dividends).Get("/{version}/stock/market/upcoming-dividends")
+	return
+}
+
 // UpcomingEarnings fetches a list of upcoming earnings from the upcoming events endpoint.
-// Pass "market" as the symbol parameter to return data for all symbols.
+// Use UpcomingEarningsMarket for the whole market.
 // https://iexcloud.io/docs/api/#upcoming-events
 func (m *Market) UpcomingEarnings(ctx context.Context, symbol string) (earnings []market.UpcomingEarning, err error) {
 	var params = map[string]string{"symbol": symbol}
@@ -58,8 +136,40 @@ func (m *Market) UpcomingEarnings(ctx context.Context, symbol string) (earnings
 	return
 }
 
-// UpcomingSplits fetches a list of upcoming earnings from the upcoming events endpoint.
-// Pass "market" as the symbol parameter to return data for all symbols.
+// UpcomingEarningsMarket fetches a list of upcoming earnings for the whole market.
+// https://iexcloud.io/docs/api/#upcoming-events
+func (m *Market) UpcomingEarningsMarket(ctx context.Context) (earnings []market.UpcomingEarning, err error) {
+	_, err = m.client.R().SetContext(ctx).SetResult(&earnings).Get("/{version}/stock/market/upcoming-earnings")
+	return
+}
+
+// UpcomingEvents returns every category of upcoming event for the given symbol in one call.
+// Use UpcomingEventsMarket for the whole market.
+// https://iexcloud.io/docs/api/#upcoming-events
+func (m *Market) UpcomingEvents(ctx context.Context, symbol string) (res *market.UpcomingEvents, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = m.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/upcoming-events")
+	return
+}
+
+// UpcomingEventsMarket returns every category of upcoming event for the whole market in one call.
+// https://iexcloud.io/docs/api/#upcoming-events
+func (m *Market) UpcomingEventsMarket(ctx context.Context) (res *market.UpcomingEvents, err error) {
+	_, err = m.client.R().SetContext(ctx).SetResult(&res).Get("/{version}/stock/market/upcoming-events")
+	return
+}
+
+// UpcomingIPOs returns the IPOs expected over the next month, updated at 5am, 10am and 8pm ET.
+// https://iexcloud.io/docs/api/#ipo-calendar
+func (m *Market) UpcomingIPOs(ctx context.Context) (res []market.IPO, err error) {
+	var ipos market.IPOs
+	_, err = m.client.R().SetContext(ctx).SetResult(&ipos).Get("/{version}/stock/market/upcoming-ipos")
+	return ipos, err
+}
+
+// UpcomingSplits fetches a list of upcoming splits from the upcoming events endpoint.
+// Use UpcomingSplitsMarket for the whole market.
 // https://iexcloud.io/docs/api/#upcoming-events
 func (m *Market) UpcomingSplits(ctx context.Context, symbol string) (splits []stock.Split, err error) {
 	var params = map[string]string{"symbol": symbol}
@@ -67,3 +177,17 @@ func (m *Market) UpcomingSplits(ctx context.Context, symbol string) (splits []st
 		Get("/{version}/stock/{symbol}/upcoming-splits")
 	return
 }
+
+// UpcomingSplitsMarket fetches a list of upcoming splits for the whole market.
+// https://iexcloud.io/docs/api/#upcoming-events
+func (m *Market) UpcomingSplitsMarket(ctx context.Context) (splits []stock.Split, err error) {
+	_, err = m.client.R().SetContext(ctx).SetResult(&splits).Get("/{version}/stock/market/upcoming-splits")
+	return
+}
+
+// Volume returns the consolidated volume of each U.S. trading venue for the current trading day.
+// https://iexcloud.io/docs/api/#u-s-exchanges
+func (m *Market) Volume(ctx context.Context) (res []market.Volume, err error) {
+	_, err = m.client.R().SetContext(ctx).SetResult(&res).Get("/{version}/stock/market/volume")
+	return
+}
@@ -34,9 +34,33 @@ var _ = Describe("Market", func() {
 		Expect(m).ToNot(BeNil())
 	})
 
+	Describe("List", func() {
+		It("should successfully get and parse a market list", func() {
+			res, err := m.List(ctx, ListMostActive, &ListOptions{Limit: 20})
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(HaveLen(20))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("SectorPerformance", func() {
+		It("should successfully get and parse sector performance", func() {
+			res, err := m.SectorPerformance(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically("~", 11, 2))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
 	Describe("UpcomingDividends", func() {
 		It("should successfully get and parse upcoming dividends", func() {
-			res, err := m.UpcomingDividends(ctx, "market")
+			res, err := m.UpcomingDividendsMarket(ctx)
 			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
 			// Historically there have always been >500 dividends, but a build
 			// failed on account of only finding 339. Regardless, expect a bunch.
@@ -49,9 +73,29 @@ var _ = Describe("Market", func() {
 		})
 	})
 
+	Describe("UpcomingEventsMarket", func() {
+		It("should successfully get and parse upcoming events", func() {
+			res, err := m.UpcomingEventsMarket(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Len()).To(BeNumerically(">", 100))
+			Expect(res.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("UpcomingIPOs", func() {
+		It("should successfully get and parse upcoming IPOs", func() {
+			res, err := m.UpcomingIPOs(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
 	Describe("UpcomingSplits", func() {
 		It("should successfully get and parse upcoming splits", func() {
-			res, err := m.UpcomingSplits(ctx, "market")
+			res, err := m.UpcomingSplitsMarket(ctx)
 			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
 			Expect(len(res)).To(BeNumerically(">", 1))
 			Expect(len(res)).To(BeNumerically("<", 1000))
@@ -61,4 +105,16 @@ var _ = Describe("Market", func() {
 			}
 		})
 	})
+
+	Describe("Volume", func() {
+		It("should successfully get and parse market volume", func() {
+			res, err := m.Volume(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically(">", 10))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
 })
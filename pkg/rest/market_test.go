@@ -18,10 +18,15 @@
 package rest_test
 
 import (
+	"net/http"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jarcoal/httpmock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onwsk8r/goiex/pkg/core/market"
 	"github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
 
 	. "github.com/onwsk8r/goiex/pkg/rest"
 )
@@ -34,9 +39,76 @@ var _ = Describe("Market", func() {
 		Expect(m).ToNot(BeNil())
 	})
 
+	Describe("List", func() {
+		Context("With default settings", GetAndVerify("/v1/stock/market/list/gainers", []stock.Quote(nil),
+			func() (interface{}, error) { return m.List(ctx, ListGainers, nil) }))
+		It("should send the options and parse the quotes", func() {
+			quotes := []*stock.Quote{stock.GoldenQuote()}
+			httpmock.RegisterResponder("GET",
+				"/v1/stock/market/list/mostactive?displayPercent=true&listLimit=25&token=sk_sometoken",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, quotes))
+			res, err := m.List(ctx, ListMostActive, &ListOptions{DisplayPercent: true, Limit: 25})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+			Expect(res).To(HaveLen(1))
+			Expect(cmp.Equal(*quotes[0], res[0])).To(BeTrue(), cmp.Diff(*quotes[0], res[0]))
+		})
+		It("should reject an invalid limit without sending a request", func() {
+			_, err := m.List(ctx, ListLosers, &ListOptions{Limit: MaxListLimit + 1})
+			Expect(err).To(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("SectorPerformance", GetAndVerify("/v1/stock/market/sector-performance",
+		market.GoldenSectorPerformance(),
+		func() (interface{}, error) { return m.SectorPerformance(ctx) }))
+
+	Describe("TodayIPOs", func() {
+		It("should get and parse the raw IPO data", func() {
+			helper.TestdataResponder("/v1/stock/market/today-ipos", "core/market/ipos.json")
+			res, err := m.TodayIPOs(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+			expected := []market.IPO(market.GoldenIPOs())
+			Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+		})
+	})
+
 	Describe("UpcomingDividends", GetAndVerify("/v1/stock/NGL/upcoming-dividends", market.GoldenUpcomingDividends(),
 		func() (interface{}, error) { return m.UpcomingDividends(ctx, "NGL") }))
 
+	Describe("UpcomingDividendsMarket", GetAndVerify("/v1/stock/market/upcoming-dividends",
+		market.GoldenUpcomingDividends(),
+		func() (interface{}, error) { return m.UpcomingDividendsMarket(ctx) }))
+
+	Describe("UpcomingEarningsMarket", GetAndVerify("/v1/stock/market/upcoming-earnings",
+		market.GoldenUpcomingEarnings(),
+		func() (interface{}, error) { return m.UpcomingEarningsMarket(ctx) }))
+
+	Describe("UpcomingEvents", GetAndVerify("/v1/stock/AAPL/upcoming-events", market.GoldenUpcomingEvents(),
+		func() (interface{}, error) { return m.UpcomingEvents(ctx, "AAPL") }))
+
+	Describe("UpcomingEventsMarket", GetAndVerify("/v1/stock/market/upcoming-events", market.GoldenUpcomingEvents(),
+		func() (interface{}, error) { return m.UpcomingEventsMarket(ctx) }))
+
+	Describe("UpcomingIPOs", func() {
+		It("should get and parse the raw IPO data", func() {
+			helper.TestdataResponder("/v1/stock/market/upcoming-ipos", "core/market/ipos.json")
+			res, err := m.UpcomingIPOs(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+			expected := []market.IPO(market.GoldenIPOs())
+			Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+		})
+	})
+
 	Describe("UpcomingSplits", GetAndVerify("/v1/stock/AAPL/upcoming-splits", stock.GoldenSplit(),
 		func() (interface{}, error) { return m.UpcomingSplits(ctx, "AAPL") }))
+
+	Describe("UpcomingSplitsMarket", GetAndVerify("/v1/stock/market/upcoming-splits", stock.GoldenSplit(),
+		func() (interface{}, error) { return m.UpcomingSplitsMarket(ctx) }))
+
+	Describe("Volume", GetAndVerify("/v1/stock/market/volume", market.GoldenVolume(),
+		func() (interface{}, error) { return m.Volume(ctx) }))
 })
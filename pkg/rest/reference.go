@@ -18,8 +18,11 @@ package rest
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/onwsk8r/goiex/pkg/core/fx"
 	"github.com/onwsk8r/goiex/pkg/core/reference"
 )
 
@@ -36,13 +39,41 @@ func NewReference(client *resty.Client) *Reference {
 }
 
 // Symbols fetches a list of symbols that are supported for making API calls.
-// The list seems to be exclusive to US equities.
+// The list seems to be exclusive to US equities. See RegionSymbols for other markets.
 // https://iexcloud.io/docs/api/#symbols
 func (r *Reference) Symbols(ctx context.Context) (symbols []reference.Symbol, err error) {
 	_, err = r.client.R().SetContext(ctx).SetResult(&symbols).Get("/{version}/ref-data/symbols")
 	return
 }
 
+// Exchanges fetches the international exchanges supported by IEX Cloud. Use
+// reference.NewExchangeDirectory to look up the exchange of an international symbol.
+// https://iexcloud.io/docs/api/#international-exchanges
+func (r *Reference) Exchanges(ctx context.Context) (exchanges []reference.Exchange, err error) {
+	_, err = r.client.R().SetContext(ctx).SetResult(&exchanges).Get("/{version}/ref-data/exchanges")
+	return
+}
+
+// RegionSymbols fetches the symbols of a region, such as "GB" or "CA", given as an
+// ISO 3166-1 alpha-2 country code. International symbols end with their exchange suffix.
+// https://iexcloud.io/docs/api/#international-symbols
+func (r *Reference) RegionSymbols(ctx context.Context, region string) (symbols []reference.Symbol, err error) {
+	var params = map[string]string{"region": region}
+	_, err = r.client.R().SetContext(ctx).SetPathParams(params).SetResult(&symbols).
+		Get("/{version}/ref-data/region/{region}/symbols")
+	return
+}
+
+// ExchangeSymbols fetches the symbols listed on an exchange, given by its Exchange code
+// such as "LON" rather than its MIC.
+// https://iexcloud.io/docs/api/#international-symbols
+func (r *Reference) ExchangeSymbols(ctx context.Context, exchange string) (symbols []reference.Symbol, err error) {
+	var params = map[string]string{"exchange": exchange}
+	_, err = r.client.R().SetContext(ctx).SetPathParams(params).SetResult(&symbols).
+		Get("/{version}/ref-data/exchange/{exchange}/symbols")
+	return
+}
+
 // OptionsSymbols fetches a list of options symbols/dates that are supported for making API calls.
 // This call returns an object keyed by symbol with the value of each symbol being an array of available contract dates.
 // https://iexcloud.io/docs/api/#options-symbols
@@ -50,3 +81,42 @@ func (r *Reference) OptionsSymbols(ctx context.Context) (symbols reference.Optio
 	_, err = r.client.R().SetContext(ctx).SetResult(&symbols).Get("/{version}/ref-data/options/symbols")
 	return
 }
+
+// FXSymbols fetches the currencies and currency pairs supported by the FX endpoints.
+// https://iexcloud.io/docs/api/#fx-symbols
+func (r *Reference) FXSymbols(ctx context.Context) (symbols *fx.Symbols, err error) {
+	_, err = r.client.R().SetContext(ctx).SetResult(&symbols).Get("/{version}/ref-data/fx/symbols")
+	return
+}
+
+// DateType selects trading days or holidays from the U.S. dates endpoint.
+type DateType string
+
+var (
+	DateTypeTrade   DateType = "trade"
+	DateTypeHoliday DateType = "holiday"
+)
+
+// DateDirection selects dates after or before the start date from the U.S. dates endpoint.
+type DateDirection string
+
+var (
+	DateDirectionNext DateDirection = "next"
+	DateDirectionLast DateDirection = "last"
+)
+
+// USDates fetches count trading days or holidays after or before start. If start is the
+// zero time, today is used. Weekends are neither trading days nor holidays.
+// See TradingCalendar for a cached calendar built on this endpoint.
+// https://iexcloud.io/docs/api/#u-s-holidays-and-trading-dates
+func (r *Reference) USDates(ctx context.Context, kind DateType, direction DateDirection, count int,
+	start time.Time) (dates []reference.TradingDate, err error) {
+	var params = map[string]string{"type": string(kind), "direction": string(direction), "last": strconv.Itoa(count)}
+	var path = "/{version}/ref-data/us/dates/{type}/{direction}/{last}"
+	if !start.IsZero() {
+		params["startDate"] = start.Format("20060102")
+		path += "/{startDate}"
+	}
+	_, err = r.client.R().SetContext(ctx).SetPathParams(params).SetResult(&dates).Get(path)
+	return
+}
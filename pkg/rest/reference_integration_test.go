@@ -19,6 +19,7 @@ package rest_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -48,6 +49,43 @@ var _ = Describe("Reference", func() {
 		})
 	})
 
+	Describe("Exchanges", func() {
+		It("should successfully get and parse the exchanges", func() {
+			res, err := r.Exchanges(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).ToNot(BeEmpty())
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("RegionSymbols", func() {
+		It("should successfully get and parse the symbols of a region", func() {
+			res, err := r.RegionSymbols(ctx, "GB")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).ToNot(BeEmpty())
+			Expect(res[0].Region).To(Equal("GB"))
+		})
+	})
+
+	Describe("ExchangeSymbols", func() {
+		It("should successfully get and parse the symbols of an exchange", func() {
+			res, err := r.ExchangeSymbols(ctx, "TSE")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).ToNot(BeEmpty())
+			Expect(res[0].Region).To(Equal("CA"))
+		})
+	})
+
+	Describe("FXSymbols", func() {
+		It("should successfully get and parse the FX symbols", func() {
+			res, err := r.FXSymbols(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Validate()).To(Succeed())
+		})
+	})
+
 	Describe("OptionsSymbols", func() {
 		It("should successfully get and parse option symbols", func() {
 			res, err := r.OptionsSymbols(ctx)
@@ -61,4 +99,28 @@ var _ = Describe("Reference", func() {
 			}
 		})
 	})
+
+	Describe("USDates", func() {
+		It("should successfully get and parse the next trading dates", func() {
+			res, err := r.USDates(ctx, DateTypeTrade, DateDirectionNext, 5, time.Time{})
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(HaveLen(5))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("TradingCalendar", func() {
+		It("should agree with the rule based calendar for a regular year", func() {
+			c := NewTradingCalendar(r)
+			from := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC)
+			days, err := c.TradingDaysBetween(ctx, from, to)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			expected, _ := RuleCalendar{}.TradingDaysBetween(ctx, from, to) // nolint:errcheck
+			Expect(days).To(Equal(expected))
+		})
+	})
 })
@@ -18,8 +18,11 @@
 package rest_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onwsk8r/goiex/pkg/core/fx"
 	"github.com/onwsk8r/goiex/pkg/core/reference"
 
 	. "github.com/onwsk8r/goiex/pkg/rest"
@@ -38,4 +41,28 @@ var _ = Describe("Reference", func() {
 
 	Describe("OptionsSymbols", GetAndVerify("/v1/ref-data/options/symbols", reference.GoldenOptionSymbol(),
 		func() (interface{}, error) { return r.OptionsSymbols(ctx) }))
+
+	Describe("Exchanges", GetAndVerify("/v1/ref-data/exchanges", reference.GoldenExchange(),
+		func() (interface{}, error) { return r.Exchanges(ctx) }))
+
+	Describe("RegionSymbols", GetAndVerify("/v1/ref-data/region/GB/symbols", reference.GoldenRegionSymbol(),
+		func() (interface{}, error) { return r.RegionSymbols(ctx, "GB") }))
+
+	Describe("ExchangeSymbols", GetAndVerify("/v1/ref-data/exchange/LON/symbols", reference.GoldenRegionSymbol(),
+		func() (interface{}, error) { return r.ExchangeSymbols(ctx, "LON") }))
+
+	Describe("FXSymbols", GetAndVerify("/v1/ref-data/fx/symbols", fx.GoldenSymbols(),
+		func() (interface{}, error) { return r.FXSymbols(ctx) }))
+
+	Describe("USDates", func() {
+		Context("With a start date", GetAndVerify("/v1/ref-data/us/dates/trade/next/3/20210701",
+			reference.GoldenTradingDate(), func() (interface{}, error) {
+				start := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+				return r.USDates(ctx, DateTypeTrade, DateDirectionNext, 3, start)
+			}))
+		Context("Without a start date", GetAndVerify("/v1/ref-data/us/dates/holiday/last/3",
+			reference.GoldenTradingDate(), func() (interface{}, error) {
+				return r.USDates(ctx, DateTypeHoliday, DateDirectionLast, 3, time.Time{})
+			}))
+	})
 })
@@ -0,0 +1,71 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/onwsk8r/goiex/pkg/core/stats"
+)
+
+// Stats exposes methods for accessing the IEX exchange's own statistics.
+// A list of endpoints can be found at https://iexcloud.io/docs/api/#stats.
+type Stats struct {
+	client *resty.Client
+}
+
+// NewStats creates a new Stats with the given client
+func NewStats(client *resty.Client) *Stats {
+	return &Stats{
+		client: client,
+	}
+}
+
+// Intraday returns the IEX exchange's statistics for the current trading day.
+// https://iexcloud.io/docs/api/#intraday
+func (s *Stats) Intraday(ctx context.Context) (res *stats.Intraday, err error) {
+	_, err = s.client.R().SetContext(ctx).SetResult(&res).Get("/{version}/stats/intraday")
+	return
+}
+
+// Recent returns the IEX exchange's statistics for each of the last five trading days.
+// https://iexcloud.io/docs/api/#recent
+func (s *Stats) Recent(ctx context.Context) (res []stats.Recent, err error) {
+	_, err = s.client.R().SetContext(ctx).SetResult(&res).Get("/{version}/stats/recent")
+	return
+}
+
+// Records returns the IEX exchange's record volume, symbols traded, routed volume and notional.
+// https://iexcloud.io/docs/api/#records
+func (s *Stats) Records(ctx context.Context) (res *stats.Records, err error) {
+	_, err = s.client.R().SetContext(ctx).SetResult(&res).Get("/{version}/stats/records")
+	return
+}
+
+// Historical returns the IEX exchange's monthly summary for the month containing the given date.
+// If month is the zero value, IEX returns the summary for the previous month.
+// https://iexcloud.io/docs/api/#historical-summary
+func (s *Stats) Historical(ctx context.Context, month time.Time) (res []stats.HistoricalSummary, err error) {
+	req := s.client.R().SetContext(ctx).SetResult(&res)
+	if !month.IsZero() {
+		req.SetQueryParam("date", month.Format("200601"))
+	}
+	_, err = req.Get("/{version}/stats/historical")
+	return
+}
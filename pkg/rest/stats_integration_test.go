@@ -0,0 +1,73 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+// +build integration
+
+package rest_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("Stats", func() {
+	var s *Stats
+
+	BeforeEach(func() {
+		s = NewStats(client)
+		Expect(s).ToNot(BeNil())
+	})
+
+	Describe("Intraday", func() {
+		It("should successfully get and parse intraday stats", func() {
+			res, err := s.Intraday(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).ToNot(BeNil())
+		})
+	})
+
+	Describe("Recent", func() {
+		It("should successfully get and parse recent stats", func() {
+			res, err := s.Recent(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically("~", 5, 1))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("Records", func() {
+		It("should successfully get and parse records", func() {
+			res, err := s.Records(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("Historical", func() {
+		It("should successfully get and parse a historical summary", func() {
+			res, err := s.Historical(ctx, time.Now().AddDate(0, -2, 0))
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).ToNot(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,57 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+// +build !integration
+
+package rest_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onwsk8r/goiex/pkg/core/stats"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("Stats", func() {
+	var s *Stats
+
+	BeforeEach(func() {
+		s = NewStats(client)
+		Expect(s).ToNot(BeNil())
+	})
+
+	Describe("Intraday", GetAndVerify("/v1/stats/intraday", stats.GoldenIntraday(),
+		func() (interface{}, error) { return s.Intraday(ctx) }))
+
+	Describe("Recent", GetAndVerify("/v1/stats/recent", stats.GoldenRecent(),
+		func() (interface{}, error) { return s.Recent(ctx) }))
+
+	Describe("Records", GetAndVerify("/v1/stats/records", stats.GoldenRecords(),
+		func() (interface{}, error) { return s.Records(ctx) }))
+
+	Describe("Historical", func() {
+		Context("For the previous month", GetAndVerify("/v1/stats/historical",
+			stats.GoldenHistoricalSummary(), func() (interface{}, error) {
+				return s.Historical(ctx, time.Time{})
+			}))
+		Context("For a given month", GetAndVerify("/v1/stats/historical?date=202106&token=sk_sometoken",
+			stats.GoldenHistoricalSummary(), func() (interface{}, error) {
+				return s.Historical(ctx, time.Date(2021, time.June, 15, 0, 0, 0, 0, time.UTC))
+			}))
+	})
+})
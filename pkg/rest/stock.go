@@ -18,6 +18,10 @@ package rest
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/onwsk8r/goiex/pkg/core/stock"
@@ -48,13 +52,16 @@ var (
 	HistoricalPeriod3m  HistoricalPeriod = "3m"
 	HistoricalPeriod1m  HistoricalPeriod = "1m"
 	HistoricalPeriod5d  HistoricalPeriod = "5d"
+	// HistoricalPeriodDate requires ChartOptions.ExactDate.
+	HistoricalPeriodDate HistoricalPeriod = "date"
 )
 
 type HistoricalIntradayPeriod string
 
 var (
-	HistoricalIntradayPeriod1mm  HistoricalIntradayPeriod = "1mm"
-	HistoricalIntradayPeriod5dm  HistoricalIntradayPeriod = "5dm"
+	HistoricalIntradayPeriod1mm HistoricalIntradayPeriod = "1mm"
+	HistoricalIntradayPeriod5dm HistoricalIntradayPeriod = "5dm"
+	// HistoricalIntradayPeriodDate requires ChartOptions.ExactDate.
 	HistoricalIntradayPeriodDate HistoricalIntradayPeriod = "date"
 )
 
@@ -74,13 +81,108 @@ var (
 // Stock exposes methods for calling Stock endoints.
 // https://iexcloud.io/docs/api/#stocks-equities
 type Stock struct {
-	client *resty.Client
+	client   *resty.Client
+	calendar Calendar
+	planHook HistoricalPlanHook
 }
 
-// NewStock creates a new Stock with the given client
+// NewStock creates a new Stock with the given client.
+// By default it uses a RuleCalendar, which derives holidays from the exchange's rules
+// without making requests, to decide which days to request. It does not know about
+// unscheduled closures, such as December 5th, 2018, so PlanHistorical and IntradayBetween
+// may request days the market was closed. Use SetCalendar with a TradingCalendar, e.g.
+// NewTradingCalendar(NewReference(client)), to use the holidays from IEX instead.
 // https://iexcloud.io/docs/api/#stocks-equities
 func NewStock(client *resty.Client) *Stock {
-	return &Stock{client: client}
+	return &Stock{client: client, calendar: RuleCalendar{}}
+}
+
+// SetCalendar sets the Calendar used by PlanHistorical and IntradayBetween to decide
+// which days the market is open, such as a TradingCalendar shared with other Stocks.
+func (s *Stock) SetCalendar(calendar Calendar) *Stock {
+	s.calendar = calendar
+	return s
+}
+
+// SetHistoricalPlanHook sets a hook that HistoricalBetween calls with its plan before
+// sending any request, e.g. to log the estimated credits or reject expensive plans.
+func (s *Stock) SetHistoricalPlanHook(hook HistoricalPlanHook) *Stock {
+	s.planHook = hook
+	return s
+}
+
+// AdvancedBonus returns bonus issues for the given symbol with an ex date between from and to,
+// inclusive. Only the dates of from and to are used, and either may be zero to leave that end open.
+// https://iexcloud.io/docs/api/#bonus-issue
+func (s *Stock) AdvancedBonus(ctx context.Context, symbol string,
+	from, to time.Time) (res []stock.CorporateAction, err error) {
+	err = timeSeries(ctx, s.client, "advanced_bonus", symbol, from, to, &res)
+	return
+}
+
+// AdvancedDistributions returns distributions (spin-offs) for the given symbol with an ex date
+// between from and to, inclusive. See AdvancedBonus for how from and to are used.
+// https://iexcloud.io/docs/api/#distribution
+func (s *Stock) AdvancedDistributions(ctx context.Context, symbol string,
+	from, to time.Time) (res []stock.CorporateAction, err error) {
+	err = timeSeries(ctx, s.client, "advanced_distribution", symbol, from, to, &res)
+	return
+}
+
+// AdvancedDividends returns dividends for the given symbol with an ex date between from and to,
+// inclusive, with more detail than Dividends. See AdvancedBonus for how from and to are used.
+// Use stock.AdvancedDividend.ToDividend to get the basic type.
+// https://iexcloud.io/docs/api/#dividends
+func (s *Stock) AdvancedDividends(ctx context.Context, symbol string,
+	from, to time.Time) (res []stock.AdvancedDividend, err error) {
+	err = timeSeries(ctx, s.client, "advanced_dividends", symbol, from, to, &res)
+	return
+}
+
+// AdvancedRights returns rights issues for the given symbol with an ex date between from and to,
+// inclusive. See AdvancedBonus for how from and to are used.
+// https://iexcloud.io/docs/api/#rights-issue
+func (s *Stock) AdvancedRights(ctx context.Context, symbol string,
+	from, to time.Time) (res []stock.CorporateAction, err error) {
+	err = timeSeries(ctx, s.client, "advanced_rights", symbol, from, to, &res)
+	return
+}
+
+// AdvancedSecurityReclassifications returns security reclassifications for the given symbol with
+// an ex date between from and to, inclusive. See AdvancedBonus for how from and to are used.
+// https://iexcloud.io/docs/api/#security-reclassification
+func (s *Stock) AdvancedSecurityReclassifications(ctx context.Context, symbol string,
+	from, to time.Time) (res []stock.CorporateAction, err error) {
+	err = timeSeries(ctx, s.client, "advanced_security_reclassification", symbol, from, to, &res)
+	return
+}
+
+// AdvancedSplits returns splits for the given symbol with an ex date between from and to,
+// inclusive, with more detail than Splits. See AdvancedBonus for how from and to are used.
+// Use stock.AdvancedSplit.ToSplit to get the basic type.
+// https://iexcloud.io/docs/api/#splits
+func (s *Stock) AdvancedSplits(ctx context.Context, symbol string,
+	from, to time.Time) (res []stock.AdvancedSplit, err error) {
+	err = timeSeries(ctx, s.client, "advanced_splits", symbol, from, to, &res)
+	return
+}
+
+// Book returns the IEX bids, asks, and trades for a symbol along with its quote.
+// https://iexcloud.io/docs/api/#book
+func (s *Stock) Book(ctx context.Context, symbol string) (res *stock.Book, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/book")
+	return
+}
+
+// DelayedQuote returns the 15 minute delayed market quote for a symbol.
+// https://iexcloud.io/docs/api/#delayed-quote
+func (s *Stock) DelayedQuote(ctx context.Context, symbol string) (res *stock.DelayedQuote, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/delayed-quote")
+	return
 }
 
 // Dividends provides basic dividend data for US equities, ETFs, and Mutual Funds for the last 5 years.
@@ -95,37 +197,325 @@ func (s *Stock) Dividends(ctx context.Context, symbol string,
 
 // Earnings data for a given company including the actual EPS, consensus, and fiscal period.
 // Available quarterly (last 4 quarters) and annually (last 4 years).
+// The options are validated before the request is sent. When opts.Field is set, only that
+// field is populated in each Earning, and the symbol is assigned from the request.
 // https://iexcloud.io/docs/api/#earnings
 func (s *Stock) Earnings(ctx context.Context, symbol string,
-	params map[string]string) (earnings []stock.Earning, err error) {
+	opts *EarningsOptions) (earnings []stock.Earning, err error) {
+	if err = opts.validate(); err != nil {
+		return
+	}
+	var pathParams = map[string]string{"symbol": symbol}
+	var path = opts.path(pathParams)
+	var req = s.client.R().SetContext(ctx).SetPathParams(pathParams).SetQueryParams(opts.query())
+
+	if opts != nil && opts.Field != "" {
+		var resp *resty.Response
+		if resp, err = req.Get(path); err != nil {
+			return
+		}
+		if earnings, err = decodeEarningsField(resp.Body(), opts.Field); err != nil {
+			return
+		}
+		for idx := range earnings {
+			earnings[idx].Symbol = symbol
+		}
+		return
+	}
+
 	var res = struct {
 		Symbol   string           `json:"symbol"`
 		Earnings *[]stock.Earning `json:"earnings"`
 	}{Earnings: &earnings}
+	_, err = req.SetResult(&res).Get(path)
+	return
+}
+
+// Estimates returns the latest consensus estimate for the next fiscal period for a given company.
+// Like Earnings, it accepts the Last and Period options; Field is not supported. The result
+// can be joined with Earnings via stock.EstimateHistory.
+// https://iexcloud.io/docs/api/#estimates
+func (s *Stock) Estimates(ctx context.Context, symbol string,
+	opts *EarningsOptions) (estimates []stock.Estimate, err error) {
+	if err = opts.validate(); err != nil {
+		return
+	} else if opts != nil && opts.Field != "" {
+		return nil, fmt.Errorf("earnings options: field is not supported by estimates")
+	}
+	var res = struct {
+		Symbol    string            `json:"symbol"`
+		Estimates *[]stock.Estimate `json:"estimates"`
+	}{Estimates: &estimates}
 	var pathParams = map[string]string{"symbol": symbol}
-	_, err = s.client.R().SetContext(ctx).SetPathParams(pathParams).SetQueryParams(params).
-		SetResult(&res).Get("/{version}/stock/{symbol}/earnings")
+	_, err = s.client.R().SetContext(ctx).SetPathParams(pathParams).SetQueryParams(opts.query()).
+		SetResult(&res).Get("/{version}/stock/{symbol}/estimates")
+	return
+}
+
+// FundOwnership returns the top 10 fund holders of the given symbol, meaning any firm not
+// defined as buy-side or sell-side such as mutual funds, pension funds, and endowments.
+// See stock.CompareHoldings for a way to compare two reporting periods.
+// https://iexcloud.io/docs/api/#fund-ownership
+func (s *Stock) FundOwnership(ctx context.Context, symbol string) (res []stock.Holder, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/fund-ownership")
 	return
 }
 
 // Historical returns adjusted and unadjusted historical data for up to 15 years.
+// The options are validated before the request is sent. When the period is
+// HistoricalPeriodDate, the data for opts.ExactDate is returned as a single daily
+// data point. Data points without a symbol, such as those returned when
+// opts.CloseOnly is set, are assigned the requested symbol.
 // https://iexcloud.io/docs/api/#historical-prices
 func (s *Stock) Historical(ctx context.Context, symbol string, period HistoricalPeriod,
-	params map[string]string) (res []stock.Historical, err error) {
+	opts *ChartOptions) (res []stock.Historical, err error) {
+	date := period == HistoricalPeriodDate
+	if err = opts.validate(false, date); err != nil {
+		return
+	}
 	var pathParams = map[string]string{"symbol": symbol, "range": string(period)}
-	_, err = s.client.R().SetContext(ctx).SetPathParams(pathParams).SetQueryParams(params).
-		SetResult(&res).Get("/{version}/stock/{symbol}/chart/{range}")
+	var path, query = opts.path(pathParams), opts.query()
+	if date {
+		query["chartByDay"] = "true"
+	}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(pathParams).SetQueryParams(query).
+		SetResult(&res).Get(path)
+	for idx := range res {
+		if res[idx].Symbol == "" {
+			res[idx].Symbol = symbol
+		}
+	}
 	return
 }
 
+// HistoricalBetween returns daily historical data from through to, inclusive.
+// It uses PlanHistorical to choose the cheapest requests as of today and passes the plan
+// to the hook set with SetHistoricalPlanHook, if any, before sending a request. The result
+// is trimmed to the requested dates.
+// It will return an error if there are no trading days between from and to, or if the
+// hook returns an error.
+// https://iexcloud.io/docs/api/#historical-prices
+func (s *Stock) HistoricalBetween(ctx context.Context, symbol string,
+	from, to time.Time) (res []stock.Historical, err error) {
+	var plan HistoricalPlan
+	if plan, err = s.PlanHistorical(ctx, from, to, time.Now()); err != nil {
+		return
+	}
+	if plan.Period == "" && len(plan.ExactDates) == 0 {
+		return nil, fmt.Errorf("no trading days between %s and %s",
+			plan.From.Format("2006-01-02"), plan.To.Format("2006-01-02"))
+	}
+	if s.planHook != nil {
+		if err = s.planHook(ctx, symbol, plan); err != nil {
+			return
+		}
+	}
+
+	if plan.Period != "" {
+		if res, err = s.Historical(ctx, symbol, plan.Period, &ChartOptions{Last: plan.Last}); err != nil {
+			return
+		}
+	}
+	for _, date := range plan.ExactDates {
+		var tmp []stock.Historical
+		if tmp, err = s.Historical(ctx, symbol, HistoricalPeriodDate, &ChartOptions{ExactDate: date}); err != nil {
+			return
+		}
+		res = append(res, tmp...)
+	}
+
+	trimmed := res[:0]
+	for idx := range res {
+		if !res[idx].Date.Before(plan.From) && !res[idx].Date.After(plan.To) {
+			trimmed = append(trimmed, res[idx])
+		}
+	}
+	return trimmed, nil
+}
+
 // HistoricalIntraday returns historical intraday data.
+// The options are validated before the request is sent. When the period is
+// HistoricalIntradayPeriodDate, the minute bars for opts.ExactDate are returned.
+// Data points without a symbol are assigned the requested symbol.
 // https://iexcloud.io/docs/api/#historical-prices
 // See also https://iexcloud.io/docs/api/#intraday-prices
 func (s *Stock) HistoricalIntraday(ctx context.Context, symbol string, period HistoricalIntradayPeriod,
-	params map[string]string) (res []stock.Intraday, err error) {
+	opts *ChartOptions) (res []stock.Intraday, err error) {
+	if err = opts.validate(true, period == HistoricalIntradayPeriodDate); err != nil {
+		return
+	}
 	var pathParams = map[string]string{"symbol": symbol, "range": string(period)}
-	_, err = s.client.R().SetContext(ctx).SetPathParams(pathParams).SetQueryParams(params).
-		SetResult(&res).Get("/{version}/stock/{symbol}/chart/{range}")
+	var path = opts.path(pathParams)
+	_, err = s.client.R().SetContext(ctx).SetPathParams(pathParams).SetQueryParams(opts.query()).
+		SetResult(&res).Get(path)
+	for idx := range res {
+		if res[idx].Symbol == "" {
+			res[idx].Symbol = symbol
+		}
+	}
+	return
+}
+
+// IntradayBetween returns the minute bars for each trading day from through to, inclusive,
+// in chronological order. Days the Stock's Calendar reports as closed are skipped
+// without sending a request for them, but the default Calendar misses unscheduled
+// closures; see NewStock. The days are fetched concurrently, subject to the client's
+// rate limiter. If any day fails, the bars for the remaining days are returned along with
+// a DayErrors describing each failure.
+// https://iexcloud.io/docs/api/#historical-prices
+func (s *Stock) IntradayBetween(ctx context.Context, symbol string,
+	from, to time.Time) (res []stock.Intraday, err error) {
+	var days []time.Time
+	if days, err = s.calendar.TradingDaysBetween(ctx, from, to); err != nil {
+		return
+	}
+	bars := make([][]stock.Intraday, len(days))
+	errs := make([]error, len(days))
+
+	var wg sync.WaitGroup
+	for idx := range days {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			opts := &ChartOptions{ExactDate: days[idx]}
+			bars[idx], errs[idx] = s.HistoricalIntraday(ctx, symbol, HistoricalIntradayPeriodDate, opts)
+		}(idx)
+	}
+	wg.Wait()
+
+	var dayErrs DayErrors
+	for idx := range days {
+		if errs[idx] != nil {
+			dayErrs = append(dayErrs, &DayError{Date: days[idx], Err: errs[idx]})
+			continue
+		}
+		res = append(res, bars[idx]...)
+	}
+	if dayErrs != nil {
+		err = dayErrs
+	}
+	return
+}
+
+// InstitutionalOwnership returns the top 10 institutional holders of the given symbol,
+// defined as buy-side or sell-side firms.
+// See stock.CompareHoldings for a way to compare two reporting periods.
+// https://iexcloud.io/docs/api/#institutional-ownership
+func (s *Stock) InstitutionalOwnership(ctx context.Context, symbol string) (res []stock.Holder, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/institutional-ownership")
+	return
+}
+
+// InsiderRoster returns the top 10 insiders for the given symbol, with the most recent information.
+// https://iexcloud.io/docs/api/#insider-roster
+func (s *Stock) InsiderRoster(ctx context.Context, symbol string) (res []stock.InsiderRosterEntry, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/insider-roster")
+	return
+}
+
+// InsiderSummary returns the insiders who have bought or sold the given symbol in the last six months.
+// https://iexcloud.io/docs/api/#insider-summary
+func (s *Stock) InsiderSummary(ctx context.Context, symbol string) (res []stock.InsiderSummary, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/insider-summary")
+	return
+}
+
+// InsiderTransactions returns the insider transactions for the given symbol.
+// See stock.NetInsiderBuying for a way to aggregate them.
+// https://iexcloud.io/docs/api/#insider-transactions
+func (s *Stock) InsiderTransactions(ctx context.Context, symbol string) (res []stock.InsiderTransaction, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/insider-transactions")
+	return
+}
+
+// LargestTrades returns the 15 minute delayed, last sale eligible trades for a symbol.
+// https://iexcloud.io/docs/api/#largest-trades
+func (s *Stock) LargestTrades(ctx context.Context, symbol string) (res []stock.Trade, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/largest-trades")
+	return
+}
+
+// News returns the last N news articles for the given symbol.
+// The API allows between 1 and 50 articles per request.
+// https://iexcloud.io/docs/api/#news
+func (s *Stock) News(ctx context.Context, symbol string, last int) (res []stock.News, err error) {
+	var params = map[string]string{"symbol": symbol, "last": strconv.Itoa(last)}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/news/last/{last}")
+	return
+}
+
+// NewsMarket returns the last N news articles for the market as a whole.
+// https://iexcloud.io/docs/api/#news
+func (s *Stock) NewsMarket(ctx context.Context, last int) (res []stock.News, err error) {
+	var params = map[string]string{"last": strconv.Itoa(last)}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/market/news/last/{last}")
+	return
+}
+
+// NewsBetween returns the news articles for the given symbol published between from and to,
+// inclusive, using the news time series. Only the dates of from and to are used.
+// https://iexcloud.io/docs/api/#historical-news
+func (s *Stock) NewsBetween(ctx context.Context, symbol string, from, to time.Time) (res []stock.News, err error) {
+	err = timeSeries(ctx, s.client, "news", symbol, from, to, &res)
+	return
+}
+
+// OHLC returns the official open and close prints for a symbol along with the day's
+// high and low. Use stock.OHLC.ToHistorical to convert it to end of day data.
+// https://iexcloud.io/docs/api/#ohlc
+func (s *Stock) OHLC(ctx context.Context, symbol string) (res *stock.OHLC, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/ohlc")
+	return
+}
+
+// OHLCMarket returns the official open and close prints for all stocks, keyed by symbol.
+// The Symbol of each stock.OHLC is set from its key.
+// https://iexcloud.io/docs/api/#ohlc
+func (s *Stock) OHLCMarket(ctx context.Context) (res map[string]stock.OHLC, err error) {
+	if _, err = s.client.R().SetContext(ctx).SetResult(&res).Get("/{version}/stock/market/ohlc"); err != nil {
+		return
+	}
+	for symbol, ohlc := range res {
+		if ohlc.Symbol == "" {
+			ohlc.Symbol = symbol
+			res[symbol] = ohlc
+		}
+	}
+	return
+}
+
+// Price returns the IEX real time price, the 15 minute delayed market price,
+// or the previous close price, depending on the plan and the time of day.
+// https://iexcloud.io/docs/api/#price-only
+func (s *Stock) Price(ctx context.Context, symbol string) (res float64, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/price")
+	return
+}
+
+// PriceTarget returns the latest average, high, and low analyst price target for a symbol.
+// https://iexcloud.io/docs/api/#price-target
+func (s *Stock) PriceTarget(ctx context.Context, symbol string) (res *stock.PriceTarget, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/price-target")
 	return
 }
 
@@ -145,6 +535,16 @@ func (s *Stock) PreviousDayMarket(ctx context.Context) (res []stock.Historical,
 	return
 }
 
+// RecommendationTrends returns the buy, sell, and hold recommendations for a symbol
+// over time. See stock.RecommendationTrend.ConsensusScore for a normalized score.
+// https://iexcloud.io/docs/api/#analyst-recommendations
+func (s *Stock) RecommendationTrends(ctx context.Context, symbol string) (res []stock.RecommendationTrend, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/recommendation-trends")
+	return
+}
+
 // Splits provides basic split data for US equities, ETFs, and Mutual Funds for the last 5 years.
 // https://iexcloud.io/docs/api/#splits-basic
 func (s *Stock) Splits(ctx context.Context, symbol string,
@@ -154,3 +554,13 @@ func (s *Stock) Splits(ctx context.Context, symbol string,
 		Get("/{version}/stock/{symbol}/splits/{range}")
 	return
 }
+
+// VolumeByVenue returns the 15 minute delayed and 30 day average consolidated volume
+// percentage of a stock, by market.
+// https://iexcloud.io/docs/api/#volume-by-venue
+func (s *Stock) VolumeByVenue(ctx context.Context, symbol string) (res []stock.VenueVolume, err error) {
+	var params = map[string]string{"symbol": symbol}
+	_, err = s.client.R().SetContext(ctx).SetPathParams(params).SetResult(&res).
+		Get("/{version}/stock/{symbol}/volume-by-venue")
+	return
+}
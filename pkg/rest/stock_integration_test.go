@@ -19,6 +19,7 @@ package rest_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -34,6 +35,50 @@ var _ = Describe("Stock", func() {
 		Expect(s).ToNot(BeNil())
 	})
 
+	Describe("AdvancedDividends", func() {
+		It("should successfully get and parse advanced dividends", func() {
+			from := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC)
+			res, err := s.AdvancedDividends(ctx, "AAPL", from, to)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically(">=", 4))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+				d := res[idx].ToDividend()
+				Expect(d.ExDate).To(Equal(res[idx].ExDate))
+			}
+		})
+	})
+
+	Describe("AdvancedSplits", func() {
+		It("should successfully get and parse advanced splits", func() {
+			res, err := s.AdvancedSplits(ctx, "AAPL", time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), time.Time{})
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically(">=", 1))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("Book", func() {
+		It("should successfully get and parse the book", func() {
+			res, err := s.Book(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("DelayedQuote", func() {
+		It("should successfully get and parse the delayed quote", func() {
+			res, err := s.DelayedQuote(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Validate()).To(Succeed())
+		})
+	})
+
 	Describe("Dividends", func() {
 		It("should successfully get and parse dividends", func() {
 			res, err := s.Dividends(ctx, "GE", DividendsPeriod1y)
@@ -48,10 +93,44 @@ var _ = Describe("Stock", func() {
 
 	Describe("Earnings", func() {
 		It("should successfully get and parse earnings", func() {
-			res, err := s.Earnings(ctx, "GOOG", map[string]string{"last": "4"})
+			res, err := s.Earnings(ctx, "GOOG", &EarningsOptions{Last: 4})
 			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
 			Expect(len(res)).To(BeNumerically("==", 4))
 
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+				Expect(res[idx].AnnounceTime.IsValid()).To(BeTrue(), string(res[idx].AnnounceTime))
+			}
+		})
+		It("should successfully get and parse a single field", func() {
+			res, err := s.Earnings(ctx, "GOOG", &EarningsOptions{Last: 2, Field: "actualEPS"})
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(HaveLen(2))
+
+			for idx := range res {
+				Expect(res[idx].ActualEPS).ToNot(BeNil())
+			}
+		})
+	})
+
+	Describe("Estimates", func() {
+		It("should successfully get and parse estimates", func() {
+			res, err := s.Estimates(ctx, "AAPL", nil)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically("==", 1))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("FundOwnership", func() {
+		It("should successfully get and parse fund ownership", func() {
+			res, err := s.FundOwnership(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically("~", 10, 5))
+
 			for idx := range res {
 				Expect(res[idx].Validate()).To(Succeed())
 			}
@@ -68,6 +147,40 @@ var _ = Describe("Stock", func() {
 				Expect(res[idx].Validate()).To(Succeed())
 			}
 		})
+		It("should successfully get and parse close-only prices", func() {
+			res, err := s.Historical(ctx, "GOOG", HistoricalPeriod1m, &ChartOptions{CloseOnly: true, Last: 5})
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(HaveLen(5))
+
+			for idx := range res {
+				Expect(res[idx].Symbol).To(Equal("GOOG"))
+				Expect(res[idx].Date.IsZero()).To(BeFalse())
+				Expect(res[idx].Close).ToNot(BeZero())
+			}
+		})
+		It("should successfully get and parse prices for a specific date", func() {
+			opts := &ChartOptions{ExactDate: time.Date(2020, time.November, 30, 0, 0, 0, 0, time.UTC)}
+			res, err := s.Historical(ctx, "GOOG", HistoricalPeriodDate, opts)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(HaveLen(1))
+			Expect(res[0].Validate()).To(Succeed())
+		})
+	})
+
+	Describe("HistoricalBetween", func() {
+		It("should successfully get and parse historical prices between two dates", func() {
+			from := time.Date(2020, time.November, 2, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2020, time.November, 30, 0, 0, 0, 0, time.UTC)
+			res, err := s.HistoricalBetween(ctx, "GOOG", from, to)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(HaveLen(20))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+				Expect(res[idx].Date).To(BeTemporally(">=", from))
+				Expect(res[idx].Date).To(BeTemporally("<=", to))
+			}
+		})
 	})
 
 	Describe("HistoricalIntraday", func() {
@@ -83,6 +196,130 @@ var _ = Describe("Stock", func() {
 		})
 	})
 
+	Describe("IntradayBetween", func() {
+		It("should successfully get and parse intraday prices across several days", func() {
+			from := time.Date(2020, time.November, 25, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2020, time.November, 30, 0, 0, 0, 0, time.UTC)
+			res, err := s.IntradayBetween(ctx, "GOOG", from, to)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			// Three trading days, one of which closed early
+			Expect(len(res)).To(BeNumerically("~", 990, 30))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(
+					SatisfyAny(Succeed(), MatchError("market close is zero")))
+			}
+		})
+	})
+
+	Describe("InstitutionalOwnership", func() {
+		It("should successfully get and parse institutional ownership", func() {
+			res, err := s.InstitutionalOwnership(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically("~", 10, 5))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("InsiderRoster", func() {
+		It("should successfully get and parse the insider roster", func() {
+			res, err := s.InsiderRoster(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically("~", 10, 5))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("InsiderSummary", func() {
+		It("should successfully get and parse the insider summary", func() {
+			res, err := s.InsiderSummary(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically(">", 0))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("InsiderTransactions", func() {
+		It("should successfully get and parse insider transactions", func() {
+			res, err := s.InsiderTransactions(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically(">", 0))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("LargestTrades", func() {
+		It("should successfully get and parse the largest trades", func() {
+			res, err := s.LargestTrades(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("News", func() {
+		It("should successfully get and parse news", func() {
+			res, err := s.News(ctx, "AAPL", 5)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically("==", 5))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("PriceTarget", func() {
+		It("should successfully get and parse the price target", func() {
+			res, err := s.PriceTarget(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("OHLC", func() {
+		It("should successfully get and parse the official prints", func() {
+			res, err := s.OHLC(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res.Validate()).To(Succeed())
+			Expect(res.ToHistorical().Date.IsZero()).To(BeFalse())
+		})
+	})
+
+	Describe("OHLCMarket", func() {
+		It("should successfully get and parse the official prints for the market", func() {
+			res, err := s.OHLCMarket(ctx)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).ToNot(BeEmpty())
+
+			for symbol := range res {
+				Expect(res[symbol].Symbol).To(Equal(symbol))
+			}
+		})
+	})
+
+	Describe("Price", func() {
+		It("should successfully get the price", func() {
+			res, err := s.Price(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(res).To(BeNumerically(">", 0))
+		})
+	})
+
 	Describe("PreviousDay", func() {
 		It("should successfully get and parse previous day prices", func() {
 			res, err := s.PreviousDay(ctx, "IBM")
@@ -111,6 +348,18 @@ var _ = Describe("Stock", func() {
 		})
 	})
 
+	Describe("RecommendationTrends", func() {
+		It("should successfully get and parse recommendation trends", func() {
+			res, err := s.RecommendationTrends(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically(">", 0))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+
 	Describe("Splits", func() {
 		It("should successfully get and parse upcoming splits", func() {
 			res, err := s.Splits(ctx, "AAPL", SplitsPeriod5y)
@@ -122,4 +371,16 @@ var _ = Describe("Stock", func() {
 			}
 		})
 	})
+
+	Describe("VolumeByVenue", func() {
+		It("should successfully get and parse volume by venue", func() {
+			res, err := s.VolumeByVenue(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically(">", 10))
+
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
 })
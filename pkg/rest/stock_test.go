@@ -18,9 +18,20 @@
 package rest_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jarcoal/httpmock"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/onwsk8r/goiex/pkg/core/stock"
+	"github.com/onwsk8r/goiex/test/helper"
 
 	. "github.com/onwsk8r/goiex/pkg/rest"
 )
@@ -33,6 +44,60 @@ var _ = Describe("Stock", func() {
 		Expect(s).ToNot(BeNil())
 	})
 
+	Describe("AdvancedBonus",
+		GetAndVerify("/v1/time-series/advanced_bonus/TEF?from=2021-01-01&token=sk_sometoken",
+			stock.GoldenCorporateActions(), func() (interface{}, error) {
+				return s.AdvancedBonus(ctx, "TEF", time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), time.Time{})
+			}))
+
+	Describe("AdvancedDistributions",
+		GetAndVerify("/v1/time-series/advanced_distribution/TEF", stock.GoldenCorporateActions(),
+			func() (interface{}, error) { return s.AdvancedDistributions(ctx, "TEF", time.Time{}, time.Time{}) }))
+
+	Describe("AdvancedDividends", func() {
+		It("should get the advanced dividends between two dates", func() {
+			helper.TestdataResponder(
+				"/v1/time-series/advanced_dividends/AAPL?from=2021-01-01&to=2021-06-30&token=sk_sometoken",
+				"core/stock/advanced_dividends.json")
+			from := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2021, time.June, 30, 0, 0, 0, 0, time.UTC)
+			res, err := s.AdvancedDividends(ctx, "AAPL", from, to)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+			expected := stock.GoldenAdvancedDividends()
+			Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+		})
+	})
+
+	Describe("AdvancedRights",
+		GetAndVerify("/v1/time-series/advanced_rights/TEF?to=2021-06-30&token=sk_sometoken",
+			stock.GoldenCorporateActions(), func() (interface{}, error) {
+				return s.AdvancedRights(ctx, "TEF", time.Time{}, time.Date(2021, time.June, 30, 0, 0, 0, 0, time.UTC))
+			}))
+
+	Describe("AdvancedSecurityReclassifications",
+		GetAndVerify("/v1/time-series/advanced_security_reclassification/TEF", stock.GoldenCorporateActions(),
+			func() (interface{}, error) {
+				return s.AdvancedSecurityReclassifications(ctx, "TEF", time.Time{}, time.Time{})
+			}))
+
+	Describe("AdvancedSplits", func() {
+		It("should get the advanced splits", func() {
+			helper.TestdataResponder("/v1/time-series/advanced_splits/AAPL", "core/stock/advanced_splits.json")
+			res, err := s.AdvancedSplits(ctx, "AAPL", time.Time{}, time.Time{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+			expected := stock.GoldenAdvancedSplits()
+			Expect(cmp.Equal(expected, res)).To(BeTrue(), cmp.Diff(expected, res))
+		})
+	})
+
+	Describe("Book", GetAndVerify("/v1/stock/AAPL/book", stock.GoldenBook(),
+		func() (interface{}, error) { return s.Book(ctx, "AAPL") }))
+
+	Describe("DelayedQuote", GetAndVerify("/v1/stock/AAPL/delayed-quote", stock.GoldenDelayedQuote(),
+		func() (interface{}, error) { return s.DelayedQuote(ctx, "AAPL") }))
+
 	Describe("Dividends (basic)", GetAndVerify("/v1/stock/NGL/dividends/ytd", stock.GoldenDividends(),
 		func() (interface{}, error) { return s.Dividends(ctx, "NGL", DividendsPeriodYTD) }))
 
@@ -43,24 +108,181 @@ var _ = Describe("Stock", func() {
 		Context("With default settings", GetAndVerify("/v1/stock/AAPL/earnings", expected,
 			func() (interface{}, error) { return s.Earnings(ctx, "AAPL", nil) }))
 		Context("When a period is passed", GetAndVerify("/v1/stock/MSFT/earnings?period=annual&token=sk_sometoken", expected,
-			func() (interface{}, error) { return s.Earnings(ctx, "MSFT", map[string]string{"period": "annual"}) }))
+			func() (interface{}, error) {
+				return s.Earnings(ctx, "MSFT", &EarningsOptions{Period: EarningsPeriodAnnual})
+			}))
 		Context("When the 'last' parameter is specified",
 			GetAndVerify("/v1/stock/TWTR/earnings?last=4&token=sk_sometoken", expected,
-				func() (interface{}, error) { return s.Earnings(ctx, "TWTR", map[string]string{"last": "4"}) }))
+				func() (interface{}, error) { return s.Earnings(ctx, "TWTR", &EarningsOptions{Last: 4}) }))
+		It("should populate a single requested field", func() {
+			httpmock.RegisterResponder("GET", "/v1/stock/AAPL/earnings/2/actualEPS?period=annual&token=sk_sometoken",
+				httpmock.NewStringResponder(http.StatusOK, "[5.07, 3.28]"))
+			opts := &EarningsOptions{Last: 2, Period: EarningsPeriodAnnual, Field: "actualEPS"}
+			res, err := s.Earnings(ctx, "AAPL", opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+			Expect(res).To(HaveLen(2))
+			Expect(res[0].Symbol).To(Equal("AAPL"))
+			Expect(*res[0].ActualEPS).To(Equal(5.07))
+			Expect(*res[1].ActualEPS).To(Equal(3.28))
+		})
+		It("should populate a single requested field for the last period", func() {
+			httpmock.RegisterResponder("GET", "/v1/stock/AAPL/earnings/1/announceTime",
+				httpmock.NewStringResponder(http.StatusOK, `"AMC"`))
+			res, err := s.Earnings(ctx, "AAPL", &EarningsOptions{Field: "announceTime"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			Expect(res[0].AnnounceTime).To(Equal(stock.AnnounceTimeAfterClose))
+		})
+		DescribeTable("should reject invalid options without sending a request",
+			func(opts *EarningsOptions) {
+				_, err := s.Earnings(ctx, "AAPL", opts)
+				Expect(err).To(HaveOccurred())
+				Expect(httpmock.GetTotalCallCount()).To(BeZero())
+			},
+			Entry("negative last", &EarningsOptions{Last: -1}),
+			Entry("unknown period", &EarningsOptions{Period: "monthly"}),
+			Entry("field with a path separator", &EarningsOptions{Field: "actualEPS/1"}),
+		)
 	})
 
+	Describe("Estimates", func() {
+		It("should unwrap the estimates from the response", func() {
+			helper.TestdataResponder("/v1/stock/AAPL/estimates?last=1&token=sk_sometoken", "core/stock/estimates.json")
+			res, err := s.Estimates(ctx, "AAPL", &EarningsOptions{Last: 1})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+			Expect(cmp.Equal(stock.GoldenEstimates(), res)).To(BeTrue(), cmp.Diff(stock.GoldenEstimates(), res))
+		})
+		It("should not accept a field", func() {
+			_, err := s.Estimates(ctx, "AAPL", &EarningsOptions{Field: "consensusEPS"})
+			Expect(err).To(MatchError("earnings options: field is not supported by estimates"))
+			Expect(httpmock.GetTotalCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("FundOwnership", GetAndVerify("/v1/stock/AAPL/fund-ownership", stock.GoldenHolders(),
+		func() (interface{}, error) { return s.FundOwnership(ctx, "AAPL") }))
+
 	Describe("Historical", func() {
 		var expected []stock.Historical
 		BeforeEach(func() { expected = stock.GoldenHistorical() })
 
 		Context("With default settings", GetAndVerify("/v1/stock/TWTR/chart/ytd", expected,
 			func() (interface{}, error) { return s.Historical(ctx, "TWTR", HistoricalPeriodYTD, nil) }))
-		Context("With query string parameters",
-			GetAndVerify("/v1/stock/MSFT/chart/ytd?chartLast=10&token=sk_sometoken", expected,
+		Context("With chart options",
+			GetAndVerify("/v1/stock/MSFT/chart/ytd?chartLast=10&chartSimplify=true&sort=desc&token=sk_sometoken",
+				expected, func() (interface{}, error) {
+					opts := &ChartOptions{Last: 10, Simplify: true, Sort: SortDescending}
+					return s.Historical(ctx, "MSFT", HistoricalPeriodYTD, opts)
+				}))
+		Context("With a specific date",
+			GetAndVerify("/v1/stock/MSFT/chart/date/20190220?chartByDay=true&token=sk_sometoken", expected,
 				func() (interface{}, error) {
-					params := map[string]string{"chartLast": "10"}
-					return s.Historical(ctx, "MSFT", HistoricalPeriodYTD, params)
+					opts := &ChartOptions{ExactDate: time.Date(2019, time.February, 20, 0, 0, 0, 0, time.UTC)}
+					return s.Historical(ctx, "MSFT", HistoricalPeriodDate, opts)
 				}))
+		It("should assign the symbol to close-only data points", func() {
+			helper.TestdataResponder("/v1/stock/AAPL/chart/1m?chartCloseOnly=true&token=sk_sometoken",
+				"core/stock/historical_close_only.json")
+			res, err := s.Historical(ctx, "AAPL", HistoricalPeriod1m, &ChartOptions{CloseOnly: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(2))
+			for idx := range res {
+				Expect(res[idx].Symbol).To(Equal("AAPL"))
+				Expect(res[idx].Close).ToNot(BeZero())
+			}
+		})
+		DescribeTable("should reject invalid options without sending a request",
+			func(period HistoricalPeriod, opts *ChartOptions) {
+				_, err := s.Historical(ctx, "AAPL", period, opts)
+				Expect(err).To(HaveOccurred())
+				Expect(httpmock.GetTotalCallCount()).To(BeZero())
+			},
+			Entry("date period without a date", HistoricalPeriodDate, nil),
+			Entry("exact date without the date period", HistoricalPeriod1m,
+				&ChartOptions{ExactDate: time.Date(2019, time.February, 20, 0, 0, 0, 0, time.UTC)}),
+			Entry("change from close", HistoricalPeriod1m, &ChartOptions{ChangeFromClose: true}),
+			Entry("by day without the date period", HistoricalPeriod1m, &ChartOptions{ByDay: true}),
+			Entry("negative last", HistoricalPeriod1m, &ChartOptions{Last: -1}),
+			Entry("negative interval", HistoricalPeriod1m, &ChartOptions{Interval: -1}),
+			Entry("unknown sort order", HistoricalPeriod1m, &ChartOptions{Sort: "up"}),
+		)
+	})
+
+	Describe("HistoricalBetween", func() {
+		var today time.Time
+		BeforeEach(func() {
+			now := time.Now()
+			today = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		})
+
+		It("should request a range and trim it to the requested dates", func() {
+			from := today.AddDate(0, 0, -40)
+			plan, err := s.PlanHistorical(ctx, from, today, time.Now())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.Period).To(Equal(HistoricalPeriod3m))
+
+			data := []stock.Historical{
+				{Symbol: "AAPL", Close: 1, Date: from.AddDate(0, 0, -1)},
+				{Symbol: "AAPL", Close: 2, Date: from},
+				{Symbol: "AAPL", Close: 3, Date: today},
+			}
+			httpmock.RegisterResponder("GET",
+				fmt.Sprintf("/v1/stock/AAPL/chart/3m?chartLast=%d&token=sk_sometoken", plan.Last),
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, data))
+			res, err := s.HistoricalBetween(ctx, "AAPL", from, today)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+			Expect(cmp.Equal(data[1:], res)).To(BeTrue(), cmp.Diff(data[1:], res))
+		})
+		It("should request exact dates when they are cheaper", func() {
+			date := today.AddDate(0, 0, -30)
+			for !IsTradingDay(date) {
+				date = date.AddDate(0, 0, -1)
+			}
+			data := []stock.Historical{{Symbol: "AAPL", Close: 1, Date: date}}
+			httpmock.RegisterResponder("GET",
+				fmt.Sprintf("/v1/stock/AAPL/chart/date/%s?chartByDay=true&token=sk_sometoken", date.Format("20060102")),
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, data))
+			var planned []HistoricalPlan
+			s.SetHistoricalPlanHook(func(_ context.Context, symbol string, plan HistoricalPlan) error {
+				Expect(symbol).To(Equal("AAPL"))
+				Expect(httpmock.GetTotalCallCount()).To(BeZero())
+				planned = append(planned, plan)
+				return nil
+			})
+			res, err := s.HistoricalBetween(ctx, "AAPL", date, date)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+			Expect(cmp.Equal(data, res)).To(BeTrue(), cmp.Diff(data, res))
+			Expect(planned).To(HaveLen(1))
+			Expect(planned[0].ExactDates).To(Equal([]time.Time{date}))
+			Expect(planned[0].Credits).To(Equal(CreditsPerExactDate))
+		})
+		It("should not send a request if the hook returns an error", func() {
+			s.SetHistoricalPlanHook(func(context.Context, string, HistoricalPlan) error {
+				return errors.New("too expensive")
+			})
+			_, err := s.HistoricalBetween(ctx, "AAPL", today.AddDate(0, 0, -40), today)
+			Expect(err).To(MatchError("too expensive"))
+			Expect(httpmock.GetTotalCallCount()).To(BeZero())
+		})
+		It("should return an error if there are no trading days", func() {
+			saturday := today.AddDate(0, 0, -7)
+			for saturday.Weekday() != time.Saturday {
+				saturday = saturday.AddDate(0, 0, 1)
+			}
+			_, err := s.HistoricalBetween(ctx, "AAPL", saturday, saturday.AddDate(0, 0, 1))
+			Expect(err).To(MatchError(fmt.Sprintf("no trading days between %s and %s",
+				saturday.Format("2006-01-02"), saturday.AddDate(0, 0, 1).Format("2006-01-02"))))
+			Expect(httpmock.GetTotalCallCount()).To(BeZero())
+		})
+		It("should not send a request for an invalid interval", func() {
+			_, err := s.HistoricalBetween(ctx, "AAPL", today, today.AddDate(0, 0, -1))
+			Expect(err).To(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(BeZero())
+		})
 	})
 
 	Describe("HistoricalIntraday", func() {
@@ -72,19 +294,185 @@ var _ = Describe("Stock", func() {
 				return s.HistoricalIntraday(ctx, "TWTR", HistoricalIntradayPeriod1mm, nil)
 			}))
 		Context("With a specific date",
-			GetAndVerify("/v1/stock/MSFT/chart/date?exactDate=20190220&token=sk_sometoken", expected,
-				func() (interface{}, error) {
-					params := map[string]string{"exactDate": "20190220"}
-					return s.HistoricalIntraday(ctx, "MSFT", HistoricalIntradayPeriodDate, params)
+			GetAndVerify("/v1/stock/MSFT/chart/date/20190220?changeFromClose=true&chartInterval=5&token=sk_sometoken",
+				expected, func() (interface{}, error) {
+					opts := &ChartOptions{
+						ExactDate:       time.Date(2019, time.February, 20, 0, 0, 0, 0, time.UTC),
+						ChangeFromClose: true,
+						Interval:        5,
+					}
+					return s.HistoricalIntraday(ctx, "MSFT", HistoricalIntradayPeriodDate, opts)
 				}))
+		DescribeTable("should reject invalid options without sending a request",
+			func(period HistoricalIntradayPeriod, opts *ChartOptions) {
+				_, err := s.HistoricalIntraday(ctx, "AAPL", period, opts)
+				Expect(err).To(HaveOccurred())
+				Expect(httpmock.GetTotalCallCount()).To(BeZero())
+			},
+			Entry("date period without a date", HistoricalIntradayPeriodDate, &ChartOptions{}),
+			Entry("close only", HistoricalIntradayPeriod1mm, &ChartOptions{CloseOnly: true}),
+			Entry("by day", HistoricalIntradayPeriod1mm, &ChartOptions{ByDay: true}),
+			Entry("include today", HistoricalIntradayPeriod1mm, &ChartOptions{IncludeToday: true}),
+		)
+	})
+
+	Describe("IntradayBetween", func() {
+		var from, to time.Time
+		BeforeEach(func() {
+			from = time.Date(2021, time.June, 30, 0, 0, 0, 0, time.UTC)
+			to = time.Date(2021, time.July, 6, 0, 0, 0, 0, time.UTC)
+		})
+		respond := func(date string, minutes ...string) {
+			var data []map[string]interface{}
+			for _, minute := range minutes {
+				data = append(data, map[string]interface{}{"date": date, "minute": minute, "close": 1})
+			}
+			url := fmt.Sprintf("/v1/stock/AAPL/chart/date/%s?token=sk_sometoken", strings.ReplaceAll(date, "-", ""))
+			httpmock.RegisterResponder("GET", url, httpmock.NewJsonResponderOrPanic(http.StatusOK, data))
+		}
+
+		It("should stitch the trading days together in order", func() {
+			respond("2021-06-30", "09:30", "09:31")
+			respond("2021-07-01", "09:30")
+			respond("2021-07-02", "09:30")
+			respond("2021-07-06", "09:30", "09:31")
+			res, err := s.IntradayBetween(ctx, "AAPL", from, to)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(4))
+			Expect(res).To(HaveLen(6))
+			for idx := range res {
+				Expect(res[idx].Symbol).To(Equal("AAPL"))
+				if idx > 0 {
+					Expect(res[idx].Date).To(BeTemporally(">", res[idx-1].Date))
+				}
+			}
+		})
+		It("should skip the days the Calendar reports as closed", func() {
+			respond("2021-06-30", "09:30")
+			respond("2021-07-01", "09:30")
+			respond("2021-07-06", "09:30")
+			closed := closedCalendar{RuleCalendar{}, time.Date(2021, time.July, 2, 0, 0, 0, 0, time.UTC)}
+			res, err := s.SetCalendar(closed).IntradayBetween(ctx, "AAPL", from, to)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(3))
+			Expect(res).To(HaveLen(3))
+		})
+		It("should report failures per day", func() {
+			respond("2021-06-30", "09:30")
+			respond("2021-07-02", "09:30")
+			httpmock.RegisterResponder("GET", "/v1/stock/AAPL/chart/date/20210701?token=sk_sometoken",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			httpmock.RegisterResponder("GET", "/v1/stock/AAPL/chart/date/20210706?token=sk_sometoken",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			res, err := s.IntradayBetween(ctx, "AAPL", from, to)
+			Expect(res).To(HaveLen(2))
+
+			var dayErrs DayErrors
+			Expect(errors.As(err, &dayErrs)).To(BeTrue())
+			Expect(dayErrs).To(HaveLen(2))
+			Expect(dayErrs[0].Date).To(Equal(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(dayErrs[1].Date).To(Equal(to))
+		})
+	})
+
+	Describe("InstitutionalOwnership", GetAndVerify("/v1/stock/AAPL/institutional-ownership",
+		stock.GoldenHolders(),
+		func() (interface{}, error) { return s.InstitutionalOwnership(ctx, "AAPL") }))
+
+	Describe("InsiderRoster", GetAndVerify("/v1/stock/AAPL/insider-roster", stock.GoldenInsiderRoster(),
+		func() (interface{}, error) { return s.InsiderRoster(ctx, "AAPL") }))
+
+	Describe("InsiderSummary", GetAndVerify("/v1/stock/AAPL/insider-summary", stock.GoldenInsiderSummary(),
+		func() (interface{}, error) { return s.InsiderSummary(ctx, "AAPL") }))
+
+	Describe("InsiderTransactions", GetAndVerify("/v1/stock/AAPL/insider-transactions",
+		stock.GoldenInsiderTransactions(),
+		func() (interface{}, error) { return s.InsiderTransactions(ctx, "AAPL") }))
+
+	Describe("LargestTrades", GetAndVerify("/v1/stock/AAPL/largest-trades", stock.GoldenLargestTrades(),
+		func() (interface{}, error) { return s.LargestTrades(ctx, "AAPL") }))
+
+	Describe("News", GetAndVerify("/v1/stock/AAPL/news/last/5", stock.GoldenNews(),
+		func() (interface{}, error) { return s.News(ctx, "AAPL", 5) }))
+
+	Describe("NewsMarket", GetAndVerify("/v1/stock/market/news/last/10", stock.GoldenNews(),
+		func() (interface{}, error) { return s.NewsMarket(ctx, 10) }))
+
+	Describe("NewsBetween",
+		GetAndVerify("/v1/time-series/news/AAPL?from=2021-06-01&to=2021-06-30&token=sk_sometoken", stock.GoldenNews(),
+			func() (interface{}, error) {
+				from := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+				to := time.Date(2021, time.June, 30, 0, 0, 0, 0, time.UTC)
+				return s.NewsBetween(ctx, "AAPL", from, to)
+			}))
+
+	Describe("PriceTarget", GetAndVerify("/v1/stock/AAPL/price-target", stock.GoldenPriceTarget(),
+		func() (interface{}, error) { return s.PriceTarget(ctx, "AAPL") }))
+
+	Describe("OHLC", func() {
+		It("should get and parse the official prints for a symbol", func() {
+			expected := stock.GoldenOHLC()["AAPL"]
+			httpmock.RegisterResponder("GET", "/v1/stock/AAPL/ohlc",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, &expected))
+			res, err := s.OHLC(ctx, "AAPL")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+			Expect(cmp.Equal(&expected, res)).To(BeTrue(), cmp.Diff(&expected, res))
+		})
+	})
+
+	Describe("OHLCMarket", func() {
+		It("should get and parse the official prints for the market", func() {
+			helper.TestdataResponder("/v1/stock/market/ohlc", "core/stock/ohlc.json")
+			res, err := s.OHLCMarket(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+			Expect(res).To(HaveLen(2))
+			Expect(res["AAPL"].Symbol).To(Equal("AAPL"))
+			Expect(res["SPY"].Symbol).To(Equal("SPY"))
+			Expect(res["SPY"].Close.Price).To(Equal(430.92))
+		})
 	})
 
+	Describe("Price", GetAndVerify("/v1/stock/AAPL/price", 143.24,
+		func() (interface{}, error) { return s.Price(ctx, "AAPL") }))
+
 	Describe("PreviousDay", GetAndVerify("/v1/stock/CSCO/previous", &stock.GoldenHistorical()[0],
 		func() (interface{}, error) { return s.PreviousDay(ctx, "CSCO") }))
 
 	Describe("PreviousDayMarket", GetAndVerify("/v1/stock/market/previous", stock.GoldenHistorical(),
 		func() (interface{}, error) { return s.PreviousDayMarket(ctx) }))
 
+	Describe("RecommendationTrends", GetAndVerify("/v1/stock/AAPL/recommendation-trends",
+		stock.GoldenRecommendationTrends(),
+		func() (interface{}, error) { return s.RecommendationTrends(ctx, "AAPL") }))
+
 	Describe("Splits (basic)", GetAndVerify("/v1/stock/AAPL/splits/5y", stock.GoldenSplit(),
 		func() (interface{}, error) { return s.Splits(ctx, "AAPL", SplitsPeriod5y) }))
+
+	Describe("VolumeByVenue", GetAndVerify("/v1/stock/AAPL/volume-by-venue", stock.GoldenVenueVolume(),
+		func() (interface{}, error) { return s.VolumeByVenue(ctx, "AAPL") }))
 })
+
+// closedCalendar is a RuleCalendar with an additional closure.
+type closedCalendar struct {
+	RuleCalendar
+	closed time.Time
+}
+
+func (c closedCalendar) IsTradingDay(ctx context.Context, t time.Time) (bool, error) {
+	if t.Equal(c.closed) {
+		return false, nil
+	}
+	return c.RuleCalendar.IsTradingDay(ctx, t)
+}
+
+func (c closedCalendar) TradingDaysBetween(ctx context.Context, from, to time.Time) (res []time.Time, err error) {
+	days, _ := c.RuleCalendar.TradingDaysBetween(ctx, from, to) // nolint:errcheck
+	for _, d := range days {
+		if !d.Equal(c.closed) {
+			res = append(res, d)
+		}
+	}
+	return
+}
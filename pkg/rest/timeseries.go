@@ -0,0 +1,309 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+)
+
+// TimeSeries exposes methods for accessing IEX time series datasets, which hold most of
+// the data that is not available from a dedicated endpoint. Each dataset has an ID, such
+// as "advanced_dividends", and its data is keyed by a key, usually a symbol, and a subkey.
+// https://iexcloud.io/docs/api/#time-series
+type TimeSeries struct {
+	client *resty.Client
+}
+
+// NewTimeSeries creates a new TimeSeries with the given client
+func NewTimeSeries(client *resty.Client) *TimeSeries {
+	return &TimeSeries{
+		client: client,
+	}
+}
+
+// TimeSeriesFormat is the format of the data returned by a time series.
+type TimeSeriesFormat string
+
+var (
+	TimeSeriesFormatJSON TimeSeriesFormat = "json"
+	TimeSeriesFormatCSV  TimeSeriesFormat = "csv"
+)
+
+// Subattribute filters a time series by a field of its data. Both the Key, which is the
+// JSON name of the field, and the Value are case sensitive.
+type Subattribute struct {
+	Key   string
+	Value string
+	// NotEqual selects the data whose field does not equal Value.
+	NotEqual bool
+}
+
+// String returns the Subattribute as it is sent to IEX, e.g. "sector|Technology".
+func (s Subattribute) String() string {
+	if s.NotEqual {
+		return s.Key + "~" + s.Value
+	}
+	return s.Key + "|" + s.Value
+}
+
+// TimeSeriesOptions holds the optional parameters accepted by TimeSeries.Get.
+// The zero value of each field leaves the corresponding parameter unset so the API default applies.
+// https://iexcloud.io/docs/api/#time-series
+type TimeSeriesOptions struct {
+	// Range is a relative date range, such as "1m", "ytd", "last-week" or "next-2-quarters".
+	// It cannot be used with From, To, or On.
+	Range string
+	// Calendar interprets Range relative to the calendar rather than the latest data,
+	// which is needed for ranges in the future.
+	Calendar bool
+	// From and To limit the data to the dates between them, inclusive.
+	From, To time.Time
+	// On returns the data for a single date. It cannot be used with From and To.
+	On time.Time
+	// Last and First are the number of most recent or oldest data points to return.
+	Last, First int
+	// Interval returns every Interval-th data point.
+	Interval int
+	// Limit is the maximum number of data points to return.
+	Limit int
+	// Subattributes filter the data by fields other than the key and subkey.
+	Subattributes []Subattribute
+	// DateField is the field used for the dates instead of the dataset's default.
+	DateField string
+	// Sort is the order of the dates: SortAscending or SortDescending, which IEX uses by default.
+	Sort SortOrder
+	// Format is TimeSeriesFormatJSON (the default) or TimeSeriesFormatCSV. CSV data is
+	// returned as is, so the result passed to TimeSeries.Get must be a *[]byte.
+	Format TimeSeriesFormat
+	// ChunkDays splits the dates from From to To into requests of at most this many days,
+	// whose results are concatenated in the order given by Sort, newest first if it is unset.
+	// When Limit is also set, a request that returns Limit data points is split in half and
+	// both halves are requested again, so the first response is paid for but discarded. A
+	// single day that returns Limit data points is an error, since it cannot be split.
+	// ChunkDays cannot be used with Last, First or Interval, which would apply to each
+	// request rather than to the whole range.
+	ChunkDays int
+}
+
+// validate returns an error if the options cannot be sent.
+func (o *TimeSeriesOptions) validate() error { // nolint:gocyclo
+	if o == nil {
+		return nil
+	}
+	hasFromTo := !o.From.IsZero() || !o.To.IsZero()
+	switch {
+	case o.Last < 0 || o.First < 0 || o.Interval < 0 || o.Limit < 0 || o.ChunkDays < 0:
+		return fmt.Errorf("time series options: last, first, interval, limit and chunk days must not be negative")
+	case o.Range != "" && (hasFromTo || !o.On.IsZero()):
+		return fmt.Errorf("time series options: range cannot be used with from, to or on")
+	case !o.On.IsZero() && hasFromTo:
+		return fmt.Errorf("time series options: on cannot be used with from or to")
+	case !o.From.IsZero() && !o.To.IsZero() && o.To.Before(o.From):
+		return fmt.Errorf("time series options: to is before from")
+	case o.Sort != "" && o.Sort != SortAscending && o.Sort != SortDescending:
+		return fmt.Errorf("time series options: invalid sort %q", o.Sort)
+	case o.Format != "" && o.Format != TimeSeriesFormatJSON && o.Format != TimeSeriesFormatCSV:
+		return fmt.Errorf("time series options: invalid format %q", o.Format)
+	case o.ChunkDays > 0 && (o.From.IsZero() || o.To.IsZero()):
+		return fmt.Errorf("time series options: chunk days requires from and to")
+	case o.ChunkDays > 0 && o.Format == TimeSeriesFormatCSV:
+		return fmt.Errorf("time series options: chunk days cannot be used with csv")
+	case o.ChunkDays > 0 && (o.Last > 0 || o.First > 0 || o.Interval > 0):
+		return fmt.Errorf("time series options: chunk days cannot be used with last, first or interval")
+	}
+	return nil
+}
+
+// descending returns true if the dates are returned newest first, as they are by default.
+func (o *TimeSeriesOptions) descending() bool {
+	return o.Sort != SortAscending
+}
+
+// query returns the query string parameters for the options. The from and to parameters
+// are set from the given dates, which are the chunk being requested.
+func (o *TimeSeriesOptions) query(from, to time.Time) map[string]string {
+	params := make(map[string]string)
+	if o == nil {
+		return params
+	}
+	set := func(key string, value int) {
+		if value > 0 {
+			params[key] = strconv.Itoa(value)
+		}
+	}
+	if o.Range != "" {
+		params["range"] = o.Range
+	}
+	if o.Calendar {
+		params["calendar"] = "true"
+	}
+	if !from.IsZero() {
+		params["from"] = from.Format("2006-01-02")
+	}
+	if !to.IsZero() {
+		params["to"] = to.Format("2006-01-02")
+	}
+	if !o.On.IsZero() {
+		params["on"] = o.On.Format("2006-01-02")
+	}
+	set("last", o.Last)
+	set("first", o.First)
+	set("interval", o.Interval)
+	set("limit", o.Limit)
+	if len(o.Subattributes) > 0 {
+		subattributes := make([]string, len(o.Subattributes))
+		for idx := range o.Subattributes {
+			subattributes[idx] = o.Subattributes[idx].String()
+		}
+		params["subattribute"] = strings.Join(subattributes, ",")
+	}
+	if o.DateField != "" {
+		params["dateField"] = o.DateField
+	}
+	if o.Sort != "" {
+		params["sort"] = string(o.Sort)
+	}
+	if o.Format != "" {
+		params["format"] = string(o.Format)
+	}
+	return params
+}
+
+// Get gets the data of a time series dataset into result, which must be a pointer to
+// a slice of a type that the data can be decoded into, or a *[]byte for CSV. Any data
+// already in result is replaced. The key and subkey may be empty to omit them from the path.
+// https://iexcloud.io/docs/api/#time-series
+func (t *TimeSeries) Get(ctx context.Context, id, key, subkey string, opts *TimeSeriesOptions,
+	result interface{}) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	var path = "/{version}/time-series/{id}"
+	var params = map[string]string{"id": id}
+	if key != "" {
+		path += "/{key}"
+		params["key"] = key
+		if subkey != "" {
+			path += "/{subkey}"
+			params["subkey"] = subkey
+		}
+	}
+
+	if opts != nil && opts.Format == TimeSeriesFormatCSV {
+		data, ok := result.(*[]byte)
+		if !ok {
+			return fmt.Errorf("time series result for csv must be a *[]byte, not %T", result)
+		}
+		resp, err := t.client.R().SetContext(ctx).SetPathParams(params).SetQueryParams(opts.query(opts.From, opts.To)).
+			Get(path)
+		if err == nil {
+			*data = resp.Body()
+		}
+		return err
+	}
+
+	slice := reflect.ValueOf(result)
+	if slice.Kind() != reflect.Ptr || slice.IsNil() || slice.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("time series result must be a pointer to a slice, not %T", result)
+	}
+	if opts == nil || opts.ChunkDays == 0 {
+		var from, to time.Time
+		if opts != nil {
+			from, to = opts.From, opts.To
+		}
+		return t.get(ctx, path, params, opts, from, to, result)
+	}
+
+	var chunks [][2]time.Time
+	from, to := day(opts.From), day(opts.To)
+	for start := from; !start.After(to); start = start.AddDate(0, 0, opts.ChunkDays) {
+		end := start.AddDate(0, 0, opts.ChunkDays-1)
+		if end.After(to) {
+			end = to
+		}
+		chunks = append(chunks, [2]time.Time{start, end})
+	}
+	if opts.descending() {
+		for i, j := 0, len(chunks)-1; i < j; i, j = i+1, j-1 {
+			chunks[i], chunks[j] = chunks[j], chunks[i]
+		}
+	}
+	res := reflect.New(slice.Elem().Type()).Elem()
+	for _, c := range chunks {
+		if err := t.chunk(ctx, path, params, opts, c[0], c[1], res); err != nil {
+			return err
+		}
+	}
+	slice.Elem().Set(res)
+	return nil
+}
+
+// chunk appends the data between from and to, inclusive, to the slice. If the data
+// reaches opts.Limit, the dates are split in half so the limit does not truncate it,
+// and the halves are appended in the order given by opts.Sort. It will return an error
+// if the data for a single day reaches the limit.
+func (t *TimeSeries) chunk(ctx context.Context, path string, params map[string]string, opts *TimeSeriesOptions,
+	from, to time.Time, slice reflect.Value) error {
+	res := reflect.New(slice.Type())
+	if err := t.get(ctx, path, params, opts, from, to, res.Interface()); err != nil {
+		return err
+	}
+	days := int(to.Sub(from).Hours() / 24)
+	if opts.Limit > 0 && res.Elem().Len() >= opts.Limit {
+		if days == 0 {
+			return fmt.Errorf("time series data for %s reached the limit of %d", from.Format("2006-01-02"), opts.Limit)
+		}
+		zerolog.Ctx(ctx).Debug().Str("from", from.Format("2006-01-02")).Str("to", to.Format("2006-01-02")).
+			Int("limit", opts.Limit).Msg("time series chunk reached the limit, splitting it")
+		mid := from.AddDate(0, 0, (days-1)/2) // nolint:gomnd
+		halves := [][2]time.Time{{from, mid}, {mid.AddDate(0, 0, 1), to}}
+		if opts.descending() {
+			halves[0], halves[1] = halves[1], halves[0]
+		}
+		for _, h := range halves {
+			if err := t.chunk(ctx, path, params, opts, h[0], h[1], slice); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	slice.Set(reflect.AppendSlice(slice, res.Elem()))
+	return nil
+}
+
+// get makes one time series request into result.
+func (t *TimeSeries) get(ctx context.Context, path string, params map[string]string, opts *TimeSeriesOptions,
+	from, to time.Time, result interface{}) error {
+	_, err := t.client.R().SetContext(ctx).SetPathParams(params).SetQueryParams(opts.query(from, to)).
+		SetResult(result).Get(path)
+	return err
+}
+
+// timeSeries gets the time series dataset for the symbol into result. The from and to
+// query string parameters are set from the dates of from and to, unless they are zero.
+func timeSeries(ctx context.Context, client *resty.Client, dataset, symbol string, from, to time.Time,
+	result interface{}) error {
+	return NewTimeSeries(client).Get(ctx, dataset, symbol, "", &TimeSeriesOptions{From: from, To: to}, result)
+}
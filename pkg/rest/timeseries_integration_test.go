@@ -0,0 +1,51 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+// +build integration
+
+package rest_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onwsk8r/goiex/pkg/core/market"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("TimeSeries", func() {
+	var t *TimeSeries
+
+	BeforeEach(func() {
+		t = NewTimeSeries(client)
+		Expect(t).ToNot(BeNil())
+	})
+
+	Describe("Get", func() {
+		It("should successfully get and parse a chunked time series", func() {
+			var res []market.EconomicPoint
+			opts := &TimeSeriesOptions{From: time.Now().AddDate(0, -2, 0), To: time.Now(), ChunkDays: 30}
+			err := t.Get(ctx, market.DatasetTreasury, "DGS10", "", opts, &res)
+			Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("%+v", err))
+			Expect(len(res)).To(BeNumerically(">", 30))
+			for idx := range res {
+				Expect(res[idx].Validate()).To(Succeed())
+			}
+		})
+	})
+})
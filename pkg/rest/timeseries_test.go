@@ -0,0 +1,221 @@
+// goiex: Golang interface to IEX Cloud API
+// Copyright (C) 2019 Brian Hazeltine
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/goiex/pkg/rest"
+)
+
+var _ = Describe("TimeSeries", func() {
+	type point struct {
+		Date  string  `json:"date"`
+		Value float64 `json:"value"`
+	}
+	var t *TimeSeries
+	date := func(d int) time.Time { return time.Date(2021, time.July, d, 0, 0, 0, 0, time.UTC) }
+	respond := func(url string, points ...point) {
+		if points == nil {
+			points = []point{}
+		}
+		httpmock.RegisterResponder("GET", url, httpmock.NewJsonResponderOrPanic(http.StatusOK, points))
+	}
+
+	BeforeEach(func() {
+		t = NewTimeSeries(client)
+		Expect(t).ToNot(BeNil())
+	})
+
+	Describe("Get", func() {
+		It("should omit an empty key and subkey", func() {
+			respond("/v1/time-series/treasury", point{Date: "2021-07-08", Value: 1.32})
+			var res []point
+			Expect(t.Get(ctx, "treasury", "", "", nil, &res)).To(Succeed())
+			Expect(res).To(Equal([]point{{Date: "2021-07-08", Value: 1.32}}))
+		})
+		It("should send the key, subkey and options", func() {
+			respond("/v1/time-series/news/AAPL/SOMEID?calendar=true&dateField=updated&first=3&interval=2"+
+				"&limit=10&range=next-week&sort=asc&subattribute=source%7CWSJ%2Clang~en&token=sk_sometoken",
+				point{Value: 1})
+			opts := &TimeSeriesOptions{
+				Range:     "next-week",
+				Calendar:  true,
+				First:     3,
+				Interval:  2,
+				Limit:     10,
+				DateField: "updated",
+				Sort:      SortAscending,
+				Subattributes: []Subattribute{
+					{Key: "source", Value: "WSJ"},
+					{Key: "lang", Value: "en", NotEqual: true},
+				},
+			}
+			var res []point
+			Expect(t.Get(ctx, "news", "AAPL", "SOMEID", opts, &res)).To(Succeed())
+			Expect(res).To(HaveLen(1))
+		})
+		It("should send the dates", func() {
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-01&last=5&to=2021-07-08&token=sk_sometoken")
+			respond("/v1/time-series/treasury/DGS10?on=2021-07-08&token=sk_sometoken")
+			var res []point
+			Expect(t.Get(ctx, "treasury", "DGS10", "",
+				&TimeSeriesOptions{From: date(1), To: date(8), Last: 5}, &res)).To(Succeed())
+			Expect(t.Get(ctx, "treasury", "DGS10", "", &TimeSeriesOptions{On: date(8)}, &res)).To(Succeed())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(2))
+		})
+		It("should replace the data already in the result", func() {
+			respond("/v1/time-series/treasury", point{Date: "2021-07-08", Value: 1.32})
+			res := []point{{Date: "existing"}, {Date: "existing"}}
+			Expect(t.Get(ctx, "treasury", "", "", nil, &res)).To(Succeed())
+			Expect(res).To(Equal([]point{{Date: "2021-07-08", Value: 1.32}}))
+		})
+		It("should return csv as is", func() {
+			httpmock.RegisterResponder("GET", "/v1/time-series/treasury/DGS10?format=csv&token=sk_sometoken",
+				httpmock.NewStringResponder(http.StatusOK, "date,value\n2021-07-08,1.32\n"))
+			var res []byte
+			Expect(t.Get(ctx, "treasury", "DGS10", "",
+				&TimeSeriesOptions{Format: TimeSeriesFormatCSV}, &res)).To(Succeed())
+			Expect(string(res)).To(Equal("date,value\n2021-07-08,1.32\n"))
+		})
+		It("should require a pointer to a slice", func() {
+			var res point
+			Expect(t.Get(ctx, "treasury", "", "", nil, &res)).
+				To(MatchError("time series result must be a pointer to a slice, not *rest_test.point"))
+			Expect(t.Get(ctx, "treasury", "", "", &TimeSeriesOptions{Format: TimeSeriesFormatCSV}, &res)).
+				To(MatchError("time series result for csv must be a *[]byte, not *rest_test.point"))
+			Expect(httpmock.GetTotalCallCount()).To(BeZero())
+		})
+		It("should return an error if the request fails", func() {
+			httpmock.RegisterResponder("GET", "/v1/time-series/nope",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			var res []point
+			Expect(t.Get(ctx, "nope", "", "", nil, &res)).ToNot(Succeed())
+		})
+	})
+
+	Describe("Chunking", func() {
+		It("should split the dates into chunks", func() {
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-01&sort=asc&to=2021-07-04&token=sk_sometoken",
+				point{Date: "2021-07-02"})
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-05&sort=asc&to=2021-07-08&token=sk_sometoken",
+				point{Date: "2021-07-06"}, point{Date: "2021-07-07"})
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-09&sort=asc&to=2021-07-10&token=sk_sometoken",
+				point{Date: "2021-07-09"})
+			res := []point{{Date: "existing"}}
+			opts := &TimeSeriesOptions{From: date(1), To: date(10).Add(time.Hour), ChunkDays: 4, Sort: SortAscending}
+			Expect(t.Get(ctx, "treasury", "DGS10", "", opts, &res)).To(Succeed())
+			Expect(res).To(Equal([]point{{Date: "2021-07-02"}, {Date: "2021-07-06"},
+				{Date: "2021-07-07"}, {Date: "2021-07-09"}}))
+			Expect(httpmock.GetTotalCallCount()).To(Equal(3))
+		})
+		It("should order the chunks to match the sort", func() {
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-01&limit=2&sort=desc&to=2021-07-04&token=sk_sometoken",
+				point{Date: "2021-07-02"}, point{Date: "2021-07-01"})
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-01&limit=2&sort=desc&to=2021-07-02&token=sk_sometoken",
+				point{Date: "2021-07-01"})
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-03&limit=2&sort=desc&to=2021-07-04&token=sk_sometoken",
+				point{Date: "2021-07-03"})
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-05&limit=2&sort=desc&to=2021-07-06&token=sk_sometoken",
+				point{Date: "2021-07-06"})
+			var res []point
+			opts := &TimeSeriesOptions{From: date(1), To: date(6), ChunkDays: 4, Limit: 2, Sort: SortDescending}
+			Expect(t.Get(ctx, "treasury", "DGS10", "", opts, &res)).To(Succeed())
+			Expect(res).To(Equal([]point{{Date: "2021-07-06"}, {Date: "2021-07-03"}, {Date: "2021-07-01"}}))
+			Expect(httpmock.GetTotalCallCount()).To(Equal(4))
+		})
+		It("should split chunks that reach the limit", func() {
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-01&limit=2&sort=asc&to=2021-07-04&token=sk_sometoken",
+				point{Date: "2021-07-01"}, point{Date: "2021-07-02"})
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-01&limit=2&sort=asc&to=2021-07-02&token=sk_sometoken",
+				point{Date: "2021-07-01"}, point{Date: "2021-07-02"})
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-01&limit=2&sort=asc&to=2021-07-01&token=sk_sometoken",
+				point{Date: "2021-07-01"})
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-02&limit=2&sort=asc&to=2021-07-02&token=sk_sometoken",
+				point{Date: "2021-07-02"})
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-03&limit=2&sort=asc&to=2021-07-04&token=sk_sometoken",
+				point{Date: "2021-07-03"})
+			var res []point
+			opts := &TimeSeriesOptions{From: date(1), To: date(4), ChunkDays: 7, Limit: 2, Sort: SortAscending}
+			Expect(t.Get(ctx, "treasury", "DGS10", "", opts, &res)).To(Succeed())
+			Expect(res).To(Equal([]point{{Date: "2021-07-01"}, {Date: "2021-07-02"}, {Date: "2021-07-03"}}))
+			Expect(httpmock.GetTotalCallCount()).To(Equal(5))
+		})
+		It("should return an error if a single day reaches the limit", func() {
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-01&limit=1&sort=asc&to=2021-07-02&token=sk_sometoken",
+				point{Date: "2021-07-01"})
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-01&limit=1&sort=asc&to=2021-07-01&token=sk_sometoken",
+				point{Date: "2021-07-01"})
+			var res []point
+			opts := &TimeSeriesOptions{From: date(1), To: date(2), ChunkDays: 2, Limit: 1, Sort: SortAscending}
+			Expect(t.Get(ctx, "treasury", "DGS10", "", opts, &res)).
+				To(MatchError("time series data for 2021-07-01 reached the limit of 1"))
+			Expect(res).To(BeEmpty())
+		})
+		It("should return the newest chunk first when no sort is given", func() {
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-01&to=2021-07-04&token=sk_sometoken",
+				point{Date: "2021-07-02"}, point{Date: "2021-07-01"})
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-05&to=2021-07-06&token=sk_sometoken",
+				point{Date: "2021-07-06"}, point{Date: "2021-07-05"})
+			var res []point
+			opts := &TimeSeriesOptions{From: date(1), To: date(6), ChunkDays: 4}
+			Expect(t.Get(ctx, "treasury", "DGS10", "", opts, &res)).To(Succeed())
+			Expect(res).To(Equal([]point{{Date: "2021-07-06"}, {Date: "2021-07-05"}, {Date: "2021-07-02"},
+				{Date: "2021-07-01"}}))
+		})
+		It("should stop at the first error", func() {
+			respond("/v1/time-series/treasury/DGS10?from=2021-07-01&sort=asc&to=2021-07-01&token=sk_sometoken")
+			httpmock.RegisterResponder("GET",
+				"/v1/time-series/treasury/DGS10?from=2021-07-02&sort=asc&to=2021-07-02&token=sk_sometoken",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			var res []point
+			opts := &TimeSeriesOptions{From: date(1), To: date(3), ChunkDays: 1, Sort: SortAscending}
+			Expect(t.Get(ctx, "treasury", "DGS10", "", opts, &res)).ToNot(Succeed())
+			Expect(httpmock.GetTotalCallCount()).To(Equal(2))
+		})
+	})
+
+	Describe("Options", func() {
+		var res []point
+		get := func(opts *TimeSeriesOptions) error { return t.Get(ctx, "treasury", "DGS10", "", opts, &res) }
+
+		It("should not send invalid options", func() {
+			Expect(get(&TimeSeriesOptions{Limit: -1})).To(MatchError(
+				"time series options: last, first, interval, limit and chunk days must not be negative"))
+			Expect(get(&TimeSeriesOptions{Range: "1m", From: date(1)})).To(MatchError(
+				"time series options: range cannot be used with from, to or on"))
+			Expect(get(&TimeSeriesOptions{On: date(1), To: date(2)})).To(MatchError(
+				"time series options: on cannot be used with from or to"))
+			Expect(get(&TimeSeriesOptions{From: date(2), To: date(1)})).To(MatchError(
+				"time series options: to is before from"))
+			Expect(get(&TimeSeriesOptions{Sort: "up"})).To(MatchError(`time series options: invalid sort "up"`))
+			Expect(get(&TimeSeriesOptions{Format: "xml"})).To(MatchError(`time series options: invalid format "xml"`))
+			Expect(get(&TimeSeriesOptions{From: date(1), ChunkDays: 5})).To(MatchError(
+				"time series options: chunk days requires from and to"))
+			Expect(get(&TimeSeriesOptions{From: date(1), To: date(2), ChunkDays: 5, Format: TimeSeriesFormatCSV})).
+				To(MatchError("time series options: chunk days cannot be used with csv"))
+			Expect(get(&TimeSeriesOptions{From: date(1), To: date(2), ChunkDays: 5, Last: 10})).
+				To(MatchError("time series options: chunk days cannot be used with last, first or interval"))
+			Expect(httpmock.GetTotalCallCount()).To(BeZero())
+		})
+	})
+})
@@ -49,11 +49,16 @@ func TestdataFromJSON(path string, target interface{}) {
 }
 
 // TestdataReponder registers an httpmock responder that responds with the given testdata.
+// The response has a JSON content type so that clients will decode it.
 func TestdataResponder(url, testdata string) {
 	rc, err := Testdata(testdata)
 	ExpectWithOffset(1, err).ToNot(HaveOccurred(), "error loading testdata file")
+	defer rc.Close()
 	data, err := ioutil.ReadAll(rc)
 	ExpectWithOffset(1, err).ToNot(HaveOccurred(), "error reading testdata file")
-	httpmock.RegisterResponder("GET", url,
-		httpmock.NewBytesResponder(http.StatusOK, data))
+	httpmock.RegisterResponder("GET", url, func(*http.Request) (*http.Response, error) {
+		resp := httpmock.NewBytesResponse(http.StatusOK, data)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	})
 }